@@ -0,0 +1,39 @@
+// Command phraseapp-mock serves an in-memory stand-in of the Phrase API for
+// offline integration tests.
+//
+//	phraseapp-mock -addr :8080 -token secret -fixtures ./fixtures
+//
+// Point clients at http://localhost:8080 as their host. See
+// phraseapptest.Server for the supported routes and phraseapptest.Fake.Seed
+// for the fixtures layout.
+package main
+
+import (
+	"flag"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/phrase/phraseapp-go/phraseapp/phraseapptest"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:8080", "address to listen on")
+	token := flag.String("token", "", "access token clients must send, any token is accepted if empty")
+	fixtures := flag.String("fixtures", "", "directory to seed projects from")
+	rateLimit := flag.Int("rate-limit", 1000, "requests allowed per rate limit window, 0 disables rate limiting")
+	window := flag.Duration("rate-limit-window", 5*time.Minute, "length of the rate limit window")
+	flag.Parse()
+
+	fake := phraseapptest.New()
+	if *fixtures != "" {
+		if err := fake.Seed(*fixtures); err != nil {
+			log.Fatalf("seeding from %s: %s", *fixtures, err)
+		}
+	}
+	fake.RateLimit = *rateLimit
+	fake.RateLimitWindow = *window
+
+	log.Printf("serving Phrase API stand-in on http://%s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, phraseapptest.NewServer(fake, *token)))
+}
@@ -3,8 +3,10 @@ module github.com/phrase/phraseapp-go
 go 1.14
 
 require (
+	github.com/BurntSushi/toml v1.2.1
 	github.com/bgentry/speakeasy v0.1.0
 	github.com/google/btree v1.0.0 // indirect
 	github.com/peterbourgon/diskv v2.0.1+incompatible
 	gopkg.in/yaml.v2 v2.2.8
+	gopkg.in/yaml.v3 v3.0.1
 )
@@ -0,0 +1,327 @@
+package phraseapp
+
+// API is implemented by Client. Depend on it, or on the smaller per-resource
+// interfaces it is made of, to replace the client in tests, e.g. with the
+// in-memory fake of the phraseapptest package.
+type API interface {
+	AccountsAPI
+	AuthorizationsAPI
+	BitbucketSyncsAPI
+	BlacklistedKeysAPI
+	BranchesAPI
+	CommentsAPI
+	DistributionsAPI
+	FormatsAPI
+	GlossaryTermTranslationsAPI
+	GlossaryTermsAPI
+	GlossariesAPI
+	InvitationsAPI
+	JobLocalesAPI
+	JobsAPI
+	KeysAPI
+	LocalesAPI
+	MembersAPI
+	OrdersAPI
+	ProjectsAPI
+	ReleasesAPI
+	ScreenshotMarkersAPI
+	ScreenshotsAPI
+	UsersAPI
+	SpacesProjectsAPI
+	SpacesAPI
+	StyleguidesAPI
+	TagsAPI
+	TranslationsAPI
+	UploadsAPI
+	VersionsAPI
+	WebhooksAPI
+}
+
+var _ API = (*Client)(nil)
+
+// AccountsAPI contains the accounts endpoints.
+type AccountsAPI interface {
+	AccountShow(id string) (*AccountDetails, error)
+	AccountsList(page, perPage int) ([]*Account, error)
+}
+
+// AuthorizationsAPI contains the authorizations endpoints.
+type AuthorizationsAPI interface {
+	AuthorizationCreate(params *AuthorizationParams) (*AuthorizationWithToken, error)
+	AuthorizationDelete(id string) error
+	AuthorizationShow(id string) (*Authorization, error)
+	AuthorizationUpdate(id string, params *AuthorizationParams) (*Authorization, error)
+	AuthorizationsList(page, perPage int) ([]*Authorization, error)
+}
+
+// BitbucketSyncsAPI contains the bitbucket syncs endpoints.
+type BitbucketSyncsAPI interface {
+	BitbucketSyncExport(id string, params *BitbucketSyncParams) (*BitbucketSyncExportResponse, error)
+	BitbucketSyncImport(id string, params *BitbucketSyncParams) error
+	BitbucketSyncsList(page, perPage int, params *BitbucketSyncParams) ([]*BitbucketSync, error)
+}
+
+// BlacklistedKeysAPI contains the blacklisted keys endpoints.
+type BlacklistedKeysAPI interface {
+	BlacklistedKeyCreate(project_id string, params *BlacklistedKeyParams) (*BlacklistedKey, error)
+	BlacklistedKeyDelete(project_id, id string) error
+	BlacklistedKeyShow(project_id, id string) (*BlacklistedKey, error)
+	BlacklistedKeyUpdate(project_id, id string, params *BlacklistedKeyParams) (*BlacklistedKey, error)
+	BlacklistedKeysList(project_id string, page, perPage int) ([]*BlacklistedKey, error)
+}
+
+// BranchesAPI contains the branches endpoints.
+type BranchesAPI interface {
+	BranchCompare(project_id, name string, params *BranchParams) error
+	BranchCreate(project_id string, params *BranchParams) (*Branch, error)
+	BranchDelete(project_id, name string) error
+	BranchMerge(project_id, name string, params *BranchMergeParams) error
+	BranchShow(project_id, name string) (*Branch, error)
+	BranchUpdate(project_id, name string, params *BranchParams) (*Branch, error)
+	BranchesList(project_id string, page, perPage int) ([]*Branch, error)
+}
+
+// CommentsAPI contains the comments endpoints.
+type CommentsAPI interface {
+	CommentCreate(project_id, key_id string, params *CommentParams) (*Comment, error)
+	CommentDelete(project_id, key_id, id string, params *CommentDeleteParams) error
+	CommentMarkCheck(project_id, key_id, id string, params *CommentMarkCheckParams) error
+	CommentMarkRead(project_id, key_id, id string, params *CommentMarkReadParams) error
+	CommentMarkUnread(project_id, key_id, id string, params *CommentMarkUnreadParams) error
+	CommentShow(project_id, key_id, id string, params *CommentShowParams) (*Comment, error)
+	CommentUpdate(project_id, key_id, id string, params *CommentParams) (*Comment, error)
+	CommentsList(project_id, key_id string, page, perPage int, params *CommentsListParams) ([]*Comment, error)
+}
+
+// DistributionsAPI contains the distributions endpoints.
+type DistributionsAPI interface {
+	DistributionCreate(account_id string, params *DistributionsParams) (*Distribution, error)
+	DistributionDelete(account_id, id string) error
+	DistributionShow(account_id, id string) (*Distribution, error)
+	DistributionUpdate(account_id, id string, params *DistributionsParams) (*Distribution, error)
+	DistributionsList(account_id string, page, perPage int) ([]*DistributionPreview, error)
+}
+
+// FormatsAPI contains the formats endpoints.
+type FormatsAPI interface {
+	FormatsList(page, perPage int) ([]*Format, error)
+}
+
+// GlossaryTermTranslationsAPI contains the glossary term translations endpoints.
+type GlossaryTermTranslationsAPI interface {
+	GlossaryTermTranslationCreate(account_id, glossary_id, term_id string, params *GlossaryTermTranslationParams) (*GlossaryTermTranslation, error)
+	GlossaryTermTranslationDelete(account_id, glossary_id, term_id, id string) error
+	GlossaryTermTranslationUpdate(account_id, glossary_id, term_id, id string, params *GlossaryTermTranslationParams) (*GlossaryTermTranslation, error)
+}
+
+// GlossaryTermsAPI contains the glossary terms endpoints.
+type GlossaryTermsAPI interface {
+	GlossaryTermCreate(account_id, glossary_id string, params *GlossaryTermParams) (*GlossaryTerm, error)
+	GlossaryTermDelete(account_id, glossary_id, id string) error
+	GlossaryTermShow(account_id, glossary_id, id string) (*GlossaryTerm, error)
+	GlossaryTermUpdate(account_id, glossary_id, id string, params *GlossaryTermParams) (*GlossaryTerm, error)
+	GlossaryTermsList(account_id, glossary_id string, page, perPage int) ([]*GlossaryTerm, error)
+}
+
+// GlossariesAPI contains the glossaries endpoints.
+type GlossariesAPI interface {
+	GlossariesList(account_id string, page, perPage int) ([]*Glossary, error)
+	GlossaryCreate(account_id string, params *GlossaryParams) (*Glossary, error)
+	GlossaryDelete(account_id, id string) error
+	GlossaryShow(account_id, id string) (*Glossary, error)
+	GlossaryUpdate(account_id, id string, params *GlossaryParams) (*Glossary, error)
+}
+
+// InvitationsAPI contains the invitations endpoints.
+type InvitationsAPI interface {
+	InvitationCreate(account_id string, params *InvitationCreateParams) (*Invitation, error)
+	InvitationDelete(account_id, id string) error
+	InvitationResend(account_id, id string) (*Invitation, error)
+	InvitationShow(account_id, id string) (*Invitation, error)
+	InvitationUpdate(account_id, id string, params *InvitationUpdateParams) (*Invitation, error)
+	InvitationsList(account_id string, page, perPage int) ([]*Invitation, error)
+}
+
+// JobLocalesAPI contains the job locales endpoints.
+type JobLocalesAPI interface {
+	JobLocaleComplete(project_id, job_id, id string, params *JobLocaleCompleteParams) (*JobLocale, error)
+	JobLocaleDelete(project_id, job_id, id string, params *JobLocaleDeleteParams) error
+	JobLocaleReopen(project_id, job_id, id string, params *JobLocaleReopenParams) (*JobLocale, error)
+	JobLocaleShow(project_id, job_id, id string, params *JobLocaleShowParams) (*JobLocale, error)
+	JobLocaleUpdate(project_id, job_id, id string, params *JobLocaleParams) (*JobLocale, error)
+	JobLocalesCreate(project_id, job_id string, params *JobLocaleParams) (*JobLocale, error)
+	JobLocalesList(project_id, job_id string, page, perPage int, params *JobLocalesListParams) ([]*JobLocale, error)
+}
+
+// JobsAPI contains the jobs endpoints.
+type JobsAPI interface {
+	JobComplete(project_id, id string, params *JobCompleteParams) (*JobDetails, error)
+	JobCreate(project_id string, params *JobParams) (*JobDetails, error)
+	JobDelete(project_id, id string, params *JobDeleteParams) error
+	JobKeysCreate(project_id, id string, params *JobKeysCreateParams) (*JobDetails, error)
+	JobKeysDelete(project_id, id string, params *JobKeysDeleteParams) error
+	JobReopen(project_id, id string, params *JobReopenParams) (*JobDetails, error)
+	JobShow(project_id, id string, params *JobShowParams) (*JobDetails, error)
+	JobStart(project_id, id string, params *JobStartParams) (*JobDetails, error)
+	JobUpdate(project_id, id string, params *JobUpdateParams) (*JobDetails, error)
+	JobsList(project_id string, page, perPage int, params *JobsListParams) ([]*Job, error)
+}
+
+// KeysAPI contains the keys endpoints.
+type KeysAPI interface {
+	KeyCreate(project_id string, params *TranslationKeyParams) (*TranslationKeyDetails, error)
+	KeyDelete(project_id, id string, params *KeyDeleteParams) error
+	KeyShow(project_id, id string, params *KeyShowParams) (*TranslationKeyDetails, error)
+	KeyUpdate(project_id, id string, params *TranslationKeyParams) (*TranslationKeyDetails, error)
+	KeysDelete(project_id string, params *KeysDeleteParams) (*AffectedResources, error)
+	KeysList(project_id string, page, perPage int, params *KeysListParams) ([]*TranslationKey, error)
+	KeysSearch(project_id string, page, perPage int, params *KeysSearchParams) ([]*TranslationKey, error)
+	KeysTag(project_id string, params *KeysTagParams) (*AffectedResources, error)
+	KeysUntag(project_id string, params *KeysUntagParams) (*AffectedResources, error)
+}
+
+// LocalesAPI contains the locales endpoints.
+type LocalesAPI interface {
+	LocaleCreate(project_id string, params *LocaleParams) (*LocaleDetails, error)
+	LocaleDelete(project_id, id string, params *LocaleDeleteParams) error
+	LocaleDownload(project_id, id string, params *LocaleDownloadParams) ([]byte, error)
+	LocaleShow(project_id, id string, params *LocaleShowParams) (*LocaleDetails, error)
+	LocaleUpdate(project_id, id string, params *LocaleParams) (*LocaleDetails, error)
+	LocalesList(project_id string, page, perPage int, params *LocalesListParams) ([]*Locale, error)
+}
+
+// MembersAPI contains the members endpoints.
+type MembersAPI interface {
+	MemberDelete(account_id, id string) error
+	MemberShow(account_id, id string) (*Member, error)
+	MemberUpdate(account_id, id string, params *MemberUpdateParams) (*Member, error)
+	MembersList(account_id string, page, perPage int) ([]*Member, error)
+}
+
+// OrdersAPI contains the orders endpoints.
+type OrdersAPI interface {
+	OrderConfirm(project_id, id string, params *OrderConfirmParams) (*TranslationOrder, error)
+	OrderCreate(project_id string, params *TranslationOrderParams) (*TranslationOrder, error)
+	OrderDelete(project_id, id string, params *OrderDeleteParams) error
+	OrderShow(project_id, id string, params *OrderShowParams) (*TranslationOrder, error)
+	OrdersList(project_id string, page, perPage int, params *OrdersListParams) ([]*TranslationOrder, error)
+}
+
+// ProjectsAPI contains the projects endpoints.
+type ProjectsAPI interface {
+	ProjectCreate(params *ProjectParams) (*ProjectDetails, error)
+	ProjectDelete(id string) error
+	ProjectShow(id string) (*ProjectDetails, error)
+	ProjectUpdate(id string, params *ProjectParams) (*ProjectDetails, error)
+	ProjectsList(page, perPage int) ([]*Project, error)
+}
+
+// ReleasesAPI contains the releases endpoints.
+type ReleasesAPI interface {
+	ReleaseCreate(account_id, distribution_id string, params *ReleasesParams) (*Release, error)
+	ReleaseDelete(account_id, distribution_id, id string) error
+	ReleasePublish(account_id, distribution_id, id string) (*Release, error)
+	ReleaseShow(account_id, distribution_id, id string) (*Release, error)
+	ReleaseUpdate(account_id, distribution_id, id string, params *ReleasesParams) (*Release, error)
+	ReleasesList(account_id, distribution_id string, page, perPage int) ([]*ReleasePreview, error)
+}
+
+// ScreenshotMarkersAPI contains the screenshot markers endpoints.
+type ScreenshotMarkersAPI interface {
+	ScreenshotMarkerCreate(project_id, screenshot_id string, params *ScreenshotMarkerParams) (*ScreenshotMarker, error)
+	ScreenshotMarkerDelete(project_id, screenshot_id string) error
+	ScreenshotMarkerShow(project_id, screenshot_id, id string) (*ScreenshotMarker, error)
+	ScreenshotMarkerUpdate(project_id, screenshot_id string, params *ScreenshotMarkerParams) (*ScreenshotMarker, error)
+	ScreenshotMarkersList(project_id, id string, page, perPage int) ([]*ScreenshotMarker, error)
+}
+
+// ScreenshotsAPI contains the screenshots endpoints.
+type ScreenshotsAPI interface {
+	ScreenshotCreate(project_id string, params *ScreenshotParams) (*Screenshot, error)
+	ScreenshotDelete(project_id, id string) error
+	ScreenshotShow(project_id, id string) (*Screenshot, error)
+	ScreenshotUpdate(project_id, id string, params *ScreenshotParams) (*Screenshot, error)
+	ScreenshotsList(project_id string, page, perPage int) ([]*Screenshot, error)
+}
+
+// UsersAPI contains the users endpoints.
+type UsersAPI interface {
+	ShowUser() (*User, error)
+}
+
+// SpacesProjectsAPI contains the spaces projects endpoints.
+type SpacesProjectsAPI interface {
+	SpacesProjectsCreate(account_id, space_id string, params *SpacesProjectsCreateParams) error
+	SpacesProjectsDelete(account_id, space_id, id string) error
+	SpacesProjectsList(account_id, space_id string, page, perPage int) ([]*Project, error)
+}
+
+// SpacesAPI contains the spaces endpoints.
+type SpacesAPI interface {
+	SpaceCreate(account_id string, params *SpaceCreateParams) (*Space, error)
+	SpaceDelete(account_id, id string) error
+	SpaceShow(account_id, id string) (*Space, error)
+	SpaceUpdate(account_id, id string, params *SpaceUpdateParams) (*Space, error)
+	SpacesList(account_id string, page, perPage int) ([]*Space, error)
+}
+
+// StyleguidesAPI contains the styleguides endpoints.
+type StyleguidesAPI interface {
+	StyleguideCreate(project_id string, params *StyleguideParams) (*StyleguideDetails, error)
+	StyleguideDelete(project_id, id string) error
+	StyleguideShow(project_id, id string) (*StyleguideDetails, error)
+	StyleguideUpdate(project_id, id string, params *StyleguideParams) (*StyleguideDetails, error)
+	StyleguidesList(project_id string, page, perPage int) ([]*Styleguide, error)
+}
+
+// TagsAPI contains the tags endpoints.
+type TagsAPI interface {
+	TagCreate(project_id string, params *TagParams) (*TagWithStats, error)
+	TagDelete(project_id, name string, params *TagDeleteParams) error
+	TagShow(project_id, name string, params *TagShowParams) (*TagWithStats, error)
+	TagsList(project_id string, page, perPage int, params *TagsListParams) ([]*Tag, error)
+}
+
+// TranslationsAPI contains the translations endpoints.
+type TranslationsAPI interface {
+	TranslationCreate(project_id string, params *TranslationParams) (*TranslationDetails, error)
+	TranslationExclude(project_id, id string, params *TranslationExcludeParams) (*TranslationDetails, error)
+	TranslationInclude(project_id, id string, params *TranslationIncludeParams) (*TranslationDetails, error)
+	TranslationReview(project_id, id string, params *TranslationReviewParams) (*TranslationDetails, error)
+	TranslationShow(project_id, id string, params *TranslationShowParams) (*TranslationDetails, error)
+	TranslationUnverify(project_id, id string, params *TranslationUnverifyParams) (*TranslationDetails, error)
+	TranslationUpdate(project_id, id string, params *TranslationUpdateParams) (*TranslationDetails, error)
+	TranslationVerify(project_id, id string, params *TranslationVerifyParams) (*TranslationDetails, error)
+	TranslationsByKey(project_id, key_id string, page, perPage int, params *TranslationsByKeyParams) ([]*Translation, error)
+	TranslationsByLocale(project_id, locale_id string, page, perPage int, params *TranslationsByLocaleParams) ([]*Translation, error)
+	TranslationsExclude(project_id string, params *TranslationsExcludeParams) (*AffectedCount, error)
+	TranslationsInclude(project_id string, params *TranslationsIncludeParams) (*AffectedCount, error)
+	TranslationsList(project_id string, page, perPage int, params *TranslationsListParams) ([]*Translation, error)
+	TranslationsReview(project_id string, params *TranslationsReviewParams) (*AffectedCount, error)
+	TranslationsSearch(project_id string, page, perPage int, params *TranslationsSearchParams) ([]*Translation, error)
+	TranslationsUnverify(project_id string, params *TranslationsUnverifyParams) (*AffectedCount, error)
+	TranslationsVerify(project_id string, params *TranslationsVerifyParams) (*AffectedCount, error)
+}
+
+// UploadsAPI contains the uploads endpoints.
+type UploadsAPI interface {
+	UploadCreate(project_id string, params *UploadParams) (*Upload, error)
+	UploadShow(project_id, id string, params *UploadShowParams) (*Upload, error)
+	UploadsList(project_id string, page, perPage int, params *UploadsListParams) ([]*Upload, error)
+}
+
+// VersionsAPI contains the versions endpoints.
+type VersionsAPI interface {
+	VersionShow(project_id, translation_id, id string, params *VersionShowParams) (*TranslationVersionWithUser, error)
+	VersionsList(project_id, translation_id string, page, perPage int, params *VersionsListParams) ([]*TranslationVersion, error)
+}
+
+// WebhooksAPI contains the webhooks endpoints.
+type WebhooksAPI interface {
+	WebhookCreate(project_id string, params *WebhookParams) (*Webhook, error)
+	WebhookDelete(project_id, id string) error
+	WebhookShow(project_id, id string) (*Webhook, error)
+	WebhookTest(project_id, id string) error
+	WebhookUpdate(project_id, id string, params *WebhookParams) (*Webhook, error)
+	WebhooksList(project_id string, page, perPage int) ([]*Webhook, error)
+}
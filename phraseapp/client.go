@@ -3,6 +3,7 @@ package phraseapp
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -10,15 +11,47 @@ import (
 	"net/url"
 	"os"
 	"strconv"
-
-	"github.com/bgentry/speakeasy"
+	"time"
 )
 
+// defaultHost is the host of clients without one set.
+const defaultHost = "https://api.phrase.com"
+
 // Client is a generic PhraseApp client. It manages a connection to the PhraseApp API
 type Client struct {
 	http.Client
 	Credentials Credentials
-	debug       bool
+
+	// CredentialProviders are asked in order for credentials if neither a
+	// token nor a username is set in Credentials.
+	CredentialProviders []CredentialProvider
+
+	// Prompter asks for password and TFA token of username based logins.
+	// Defaults to TerminalPrompter.
+	Prompter Prompter
+
+	// Logger receives structured events about requests and responses. If
+	// unset, debug clients log to stderr.
+	Logger Logger
+
+	// Middlewares wrap every request sent by the client, see Use.
+	Middlewares []Middleware
+
+	// Metrics records counters, latencies and errors of every API call.
+	Metrics MetricsCollector
+
+	// Tracer receives a span per API call, see Tracer.
+	Tracer Tracer
+
+	// ValidateParams makes the client validate params before sending
+	// requests, see Validate of the params types.
+	ValidateParams bool
+
+	// Formats caches the file formats of the host. It is set on first use
+	// if nil.
+	Formats *FormatRegistry
+
+	debug bool
 }
 
 // Credentials contains all information to authenticate against phrase.com or a custom host.
@@ -37,13 +70,35 @@ func NewClient(credentials Credentials, debug bool) (*Client, error) {
 		Credentials: credentials,
 		debug:       debug,
 	}
+	client.Formats = NewFormatRegistry(client)
 
 	return client, nil
 }
 
+// NewClientForProfile initializes a new client with the credentials and
+// debug setting of the named profile of the config, see
+// ReadConfigWithOverrides. An empty name selects the profile as ReadConfig
+// does.
+func NewClientForProfile(profile string) (*Client, error) {
+	var overrides map[string]interface{}
+	if profile != "" {
+		overrides = map[string]interface{}{"profile": profile}
+	}
+	cfg, err := ReadConfigWithOverrides(overrides)
+	if err != nil {
+		return nil, err
+	}
+	return NewClient(cfg.Credentials, cfg.Debug)
+}
+
 // EnableCaching for API requests on disk via etags
 func (client *Client) EnableCaching(config CacheConfig) error {
-	cache, err := newHTTPCacheClient(client.debug, config)
+	logger := config.Logger
+	if logger == nil {
+		logger = client.logger()
+	}
+
+	cache, err := newHTTPCacheClient(logger, config)
 	if err != nil {
 		return err
 	}
@@ -68,7 +123,7 @@ func (c *Credentials) init() {
 		if envHost != "" {
 			c.Host = envHost
 		} else {
-			c.Host = "https://api.phrase.com"
+			c.Host = defaultHost
 		}
 	}
 }
@@ -77,21 +132,29 @@ func (client *Client) authenticate(req *http.Request) error {
 	if client.Credentials.Token != "" {
 		req.Header.Set("Authorization", "token "+client.Credentials.Token)
 	} else if client.Credentials.Username != "" {
-		pwd, err := speakeasy.Ask("Password: ")
+		pwd, err := client.prompter().Password(client.Credentials.Username)
 		if err != nil {
 			return err
 		}
-		req.SetBasicAuth(client.Credentials.Username, pwd)
-
-		if client.Credentials.TFA { // TFA only required for username+password based login.
-			token, err := speakeasy.Ask("TFA-Token: ")
+		err = client.setBasicAuth(req, client.Credentials.Username, pwd)
+		if err != nil {
+			return err
+		}
+	} else {
+		secret, err := client.providedSecret()
+		switch {
+		case err != nil:
+			return err
+		case secret == nil:
+			return fmt.Errorf("either username or token must be given")
+		case secret.Token != "":
+			req.Header.Set("Authorization", "token "+secret.Token)
+		default:
+			err = client.setBasicAuth(req, secret.Username, secret.Password)
 			if err != nil {
 				return err
 			}
-			req.Header.Set("X-PhraseApp-OTP", token)
 		}
-	} else {
-		return fmt.Errorf("either username or token must be given")
 	}
 
 	req.Header.Set("User-Agent", GetUserAgent())
@@ -99,7 +162,40 @@ func (client *Client) authenticate(req *http.Request) error {
 	return nil
 }
 
-func (client *Client) sendRequestPaginated(method, urlPath, contentType string, body io.Reader, expectedStatus, page, perPage int) (io.ReadCloser, error) {
+func (client *Client) setBasicAuth(req *http.Request, username, pwd string) error {
+	req.SetBasicAuth(username, pwd)
+
+	if client.Credentials.TFA { // TFA only required for username+password based login.
+		token, err := client.prompter().OTP(username)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("X-PhraseApp-OTP", token)
+	}
+	return nil
+}
+
+func (client *Client) prompter() Prompter {
+	if client.Prompter == nil {
+		return TerminalPrompter{}
+	}
+	return client.Prompter
+}
+
+func (client *Client) providedSecret() (*Secret, error) {
+	for _, provider := range client.CredentialProviders {
+		secret, err := provider.Secret(client.Credentials.Host)
+		if err != nil {
+			return nil, err
+		}
+		if secret != nil && (secret.Token != "" || secret.Username != "") {
+			return secret, nil
+		}
+	}
+	return nil, nil
+}
+
+func (client *Client) sendRequestPaginated(ctx context.Context, method, urlPath, contentType string, body io.Reader, expectedStatus, page, perPage int) (io.ReadCloser, error) {
 	endpointURL, err := url.Parse(client.Credentials.Host + urlPath)
 	if err != nil {
 		return nil, err
@@ -112,7 +208,7 @@ func (client *Client) sendRequestPaginated(method, urlPath, contentType string,
 		return nil, err
 	}
 
-	resp, err := client.send(req, expectedStatus)
+	resp, err := client.send(ctx, req, expectedStatus)
 	if err != nil {
 		return nil, err
 	}
@@ -120,7 +216,7 @@ func (client *Client) sendRequestPaginated(method, urlPath, contentType string,
 	return resp.Body, nil
 }
 
-func (client *Client) sendGetRequestPaginated(urlPath string, params map[string]string, expectedStatus, page, perPage int) (io.ReadCloser, error) {
+func (client *Client) sendGetRequestPaginated(ctx context.Context, urlPath string, params map[string]string, expectedStatus, page, perPage int) (io.ReadCloser, error) {
 	endpointURL, err := url.Parse(client.Credentials.Host + urlPath)
 	if err != nil {
 		return nil, err
@@ -139,7 +235,7 @@ func (client *Client) sendGetRequestPaginated(urlPath string, params map[string]
 	}
 
 	req.URL.RawQuery = values.Encode()
-	resp, err := client.send(req, expectedStatus)
+	resp, err := client.send(ctx, req, expectedStatus)
 	if err != nil {
 		return nil, err
 	}
@@ -148,7 +244,7 @@ func (client *Client) sendGetRequestPaginated(urlPath string, params map[string]
 }
 
 
-func (client *Client) sendRequest(method, urlPath, contentType string, body io.Reader, expectedStatus int) (io.ReadCloser, error) {
+func (client *Client) sendRequest(ctx context.Context, method, urlPath, contentType string, body io.Reader, expectedStatus int) (io.ReadCloser, error) {
 	endpointURL, err := url.Parse(client.Credentials.Host + urlPath)
 	if err != nil {
 		return nil, err
@@ -159,14 +255,14 @@ func (client *Client) sendRequest(method, urlPath, contentType string, body io.R
 		return nil, err
 	}
 
-	resp, err := client.send(req, expectedStatus)
+	resp, err := client.send(ctx, req, expectedStatus)
 	if err != nil {
 		return nil, err
 	}
 	return resp.Body, nil
 }
 
-func (client *Client) sendGetRequest(urlPath string, params map[string]string, expectedStatus int) (io.ReadCloser, error) {
+func (client *Client) sendGetRequest(ctx context.Context, urlPath string, params map[string]string, expectedStatus int) (io.ReadCloser, error) {
 	endpointURL, err := url.Parse(client.Credentials.Host + urlPath)
 	if err != nil {
 		return nil, err
@@ -183,7 +279,7 @@ func (client *Client) sendGetRequest(urlPath string, params map[string]string, e
 	}
 
 	req.URL.RawQuery = values.Encode()
-	resp, err := client.send(req, expectedStatus)
+	resp, err := client.send(ctx, req, expectedStatus)
 	if err != nil {
 		return nil, err
 	}
@@ -191,32 +287,74 @@ func (client *Client) sendGetRequest(urlPath string, params map[string]string, e
 }
 
 
-func (client *Client) send(req *http.Request, expectedStatus int) (*http.Response, error) {
+func (client *Client) send(ctx context.Context, req *http.Request, expectedStatus int) (*http.Response, error) {
 	err := client.authenticate(req)
 	if err != nil {
 		return nil, err
 	}
-
-	if client.debug {
-		b := new(bytes.Buffer)
-		err = req.Header.Write(b)
-		if err != nil {
-			return nil, err
+	req = req.WithContext(ctx)
+	endpoint := RequestEndpoint(req)
+
+	logger := client.logger()
+	if logger.Enabled(LevelDebug) {
+		fields := []LogField{{"method", req.Method}, {"url", redactURL(req.URL)}}
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			b, err := ioutil.ReadAll(body)
+			if err != nil {
+				return nil, err
+			}
+			fields = append(fields, LogField{"body", debugBody(b, req.Header.Get("Content-Type"))})
 		}
-
-		fmt.Fprintln(os.Stderr, "Header:", b.String())
+		logger.Log(LevelDebug, "request", fields...)
+		logger.Log(LevelDebug, "request headers", LogField{"header", redactHeader(req.Header)})
 	}
 
-	resp, err := client.Client.Do(req)
+	_, span := startChildSpan(ctx, "http")
+	span.SetAttribute("method", req.Method)
+	span.SetAttribute("path", req.URL.Path)
+	start := time.Now()
+	resp, err := client.roundTrip(req)
+	if resp != nil {
+		span.SetAttribute("status", resp.StatusCode)
+	}
+	endSpan(span, err)
 	if err != nil {
+		logger.Log(LevelError, "request failed",
+			LogField{"endpoint", endpoint}, LogField{"method", req.Method}, LogField{"path", req.URL.Path},
+			LogField{"duration", time.Since(start)}, LogField{"error", err})
+		client.recordMetrics(endpoint, req, nil, err, start)
 		return nil, err
 	}
 
-	if client.debug {
-		fmt.Fprintf(os.Stderr, "\nResponse HTTP Status Code: %s\n", resp.Status)
+	var body []byte
+	size := resp.ContentLength
+	if logger.Enabled(LevelDebug) {
+		body, err = ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		size = int64(len(body))
+	}
+
+	level := LevelInfo
+	if resp.StatusCode != expectedStatus {
+		level = LevelWarn
+	}
+	logger.Log(level, "response",
+		LogField{"endpoint", endpoint}, LogField{"method", req.Method}, LogField{"path", req.URL.Path}, LogField{"status", resp.StatusCode},
+		LogField{"duration", time.Since(start)}, LogField{"bytes", size}, LogField{"cache_hit", resp.Header.Get(cacheHeader) == "hit"})
+	if body != nil {
+		logger.Log(LevelDebug, "response body", LogField{"body", truncateDebug(body)})
 	}
 
 	err = handleResponseStatus(resp, expectedStatus)
+	client.recordMetrics(endpoint, req, resp, err, start)
 	if err != nil {
 		resp.Body.Close()
 	}
@@ -232,21 +370,6 @@ func addPagination(u *url.URL, page, perPage int) {
 }
 
 func (client *Client) buildRequest(method string, u *url.URL, body io.Reader, contentType string) (*http.Request, error) {
-	if client.debug {
-		fmt.Fprintln(os.Stderr, "Method:", method)
-		fmt.Fprintln(os.Stderr, "URL:", u)
-
-		if body != nil {
-			bodyBytes, err := ioutil.ReadAll(body)
-			if err != nil {
-				fmt.Fprintln(os.Stderr, "Error reading body:", err.Error())
-			}
-
-			fmt.Fprintln(os.Stderr, "Body:", string(bodyBytes))
-			body = bytes.NewReader(bodyBytes)
-		}
-	}
-
 	req, err := http.NewRequest(method, u.String(), body)
 	if err != nil {
 		return nil, err
@@ -1,11 +1,7 @@
 package phraseapp
 
 import (
-	"errors"
 	"fmt"
-	"io/ioutil"
-	"os"
-	"path/filepath"
 
 	"gopkg.in/yaml.v2"
 )
@@ -21,86 +17,37 @@ type Config struct {
 	DefaultProjectID  string
 	DefaultFileFormat string
 
+	// Profile is the name of the selected profile, see
+	// ReadConfigWithOverrides.
+	Profile string
+
 	Defaults map[string]map[string]interface{}
 
 	Targets []byte
 	Sources []byte
-}
-
-var configNames = []string{".phrase.yml", ".phraseapp.yml"}
-
-// ReadConfig reads a .phrase.yml config file
-func ReadConfig() (*Config, error) {
-	rawCfg := map[string]*Config{}
-	content, err := configContent()
-	switch {
-	case err != nil:
-		return nil, err
-	case content == nil:
-		return &Config{}, nil
-	default:
-		err := yaml.Unmarshal(content, rawCfg)
-		if err != nil {
-			return nil, err
-		}
 
-		if cfg, found := rawCfg["phrase"]; found {
-			return cfg, nil
-		}
-		if cfg, found := rawCfg["phraseapp"]; found {
-			return cfg, nil
-		}
+	// Location is the file taking precedence and why it was chosen.
+	Location *ConfigLocation
 
-		return nil, errors.New("'phrase' key is missing in config")
-	}
+	origins map[string]*ConfigOrigin
+	// read holds the values of a config of ReadConfigFile, see WriteConfig.
+	read *configSnapshot
 }
 
-func configContent() ([]byte, error) {
-	path, err := configPath()
-	switch {
-	case err != nil:
-		return nil, err
-	case path == "":
-		return nil, nil
-	default:
-		return ioutil.ReadFile(path)
-	}
+var configNames = []string{".phrase.yml", ".phrase.json", ".phrase.toml", ".phraseapp.yml"}
+
+// ReadConfig reads the config from the discovered config files and the
+// PHRASEAPP_* environment variables, see ReadConfigWithOverrides.
+func ReadConfig() (*Config, error) {
+	return ReadConfigWithOverrides(nil)
 }
 
 func configPath() (string, error) {
-	if possiblePath := os.Getenv("PHRASEAPP_CONFIG"); possiblePath != "" {
-		_, err := os.Stat(possiblePath)
-		if err == nil {
-			return possiblePath, nil
-		}
-
-		if os.IsNotExist(err) {
-			err = fmt.Errorf("file %q (from PHRASEAPP_CONFIG environment variable) doesn't exist", possiblePath)
-		}
-
+	location, err := DiscoverConfig()
+	if err != nil || location == nil {
 		return "", err
 	}
-
-	workingDir, err := os.Getwd()
-	if err != nil {
-		return "", nil
-	}
-
-	for _, configName := range configNames {
-		possiblePath := filepath.Join(workingDir, configName)
-		if _, err := os.Stat(possiblePath); err == nil {
-			return possiblePath, nil
-		}
-	}
-
-	for _, configName := range configNames {
-		possiblePath := filepath.Join(defaultConfigDir(), configName)
-		if _, err := os.Stat(possiblePath); err == nil {
-			return possiblePath, nil
-		}
-	}
-
-	return "", nil
+	return location.Path, nil
 }
 
 func (cfg *Config) UnmarshalYAML(unmarshal func(i interface{}) error) error {
@@ -113,6 +60,7 @@ func (cfg *Config) UnmarshalYAML(unmarshal func(i interface{}) error) error {
 		"per_page":     &cfg.PerPage,
 		"project_id":   &cfg.DefaultProjectID,
 		"file_format":  &cfg.DefaultFileFormat,
+		"profile":      &cfg.Profile,
 		"push":         &cfg.Sources,
 		"pull":         &cfg.Targets,
 		"defaults":     &m,
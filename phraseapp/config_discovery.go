@@ -0,0 +1,126 @@
+package phraseapp
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// ConfigLocation is a config file found by DiscoverConfigs, with the reason
+// it was considered.
+type ConfigLocation struct {
+	Path   string
+	Reason string
+}
+
+func (l *ConfigLocation) String() string {
+	return fmt.Sprintf("%s (%s)", l.Path, l.Reason)
+}
+
+// DiscoverConfig returns the config file ReadConfig uses, or nil if there is
+// none. See DiscoverConfigs for the order of precedence.
+func DiscoverConfig() (*ConfigLocation, error) {
+	locations, err := DiscoverConfigs()
+	if err != nil || len(locations) == 0 {
+		return nil, err
+	}
+	return locations[0], nil
+}
+
+// DiscoverConfigs returns the config files applying to the working
+// directory, the one taking precedence first:
+//
+// A file set in the PHRASEAPP_CONFIG environment variable is the only one
+// returned. Otherwise the working directory and its parents are searched up
+// to the repository root, i.e. the first directory containing .git, or the
+// filesystem root. The nearest file comes first, so projects of a monorepo
+// can have their own config next to a shared one at the root. Then come
+// $XDG_CONFIG_HOME/phrase/config.yml and the home directory's config.
+//
+// Config files may also be JSON or TOML, named .phrase.json and .phrase.toml,
+// or config.json and config.toml in the XDG config directory.
+func DiscoverConfigs() ([]*ConfigLocation, error) {
+	if possiblePath := os.Getenv("PHRASEAPP_CONFIG"); possiblePath != "" {
+		_, err := os.Stat(possiblePath)
+		if err == nil {
+			return []*ConfigLocation{{Path: possiblePath, Reason: "set in PHRASEAPP_CONFIG environment variable"}}, nil
+		}
+
+		if os.IsNotExist(err) {
+			err = fmt.Errorf("file %q (from PHRASEAPP_CONFIG environment variable) doesn't exist", possiblePath)
+		}
+
+		return nil, err
+	}
+
+	var locations []*ConfigLocation
+	seen := map[string]bool{}
+
+	workingDir, err := os.Getwd()
+	if err == nil {
+		for dir := workingDir; ; {
+			seen[dir] = true
+			if path := findConfigIn(dir, configNames); path != "" {
+				reason := "in working directory"
+				if dir != workingDir {
+					reason = "in parent directory of working directory " + workingDir
+				}
+				locations = append(locations, &ConfigLocation{Path: path, Reason: reason})
+			}
+
+			parent := filepath.Dir(dir)
+			if isRepositoryRoot(dir) || parent == dir {
+				break
+			}
+			dir = parent
+		}
+	}
+
+	if dir := xdgConfigDir(); dir != "" {
+		if path := findConfigIn(dir, xdgConfigNames); path != "" {
+			locations = append(locations, &ConfigLocation{Path: path, Reason: "in XDG config directory"})
+		}
+	}
+
+	if home := defaultConfigDir(); home != "" && !seen[filepath.Clean(home)] {
+		if path := findConfigIn(home, configNames); path != "" {
+			locations = append(locations, &ConfigLocation{Path: path, Reason: "in home directory"})
+		}
+	}
+
+	return locations, nil
+}
+
+// findConfigIn returns the path of the first of the config files named
+// configNames in dir, e.g. .phrase.yml before the legacy .phraseapp.yml.
+func findConfigIn(dir string, configNames []string) string {
+	for _, configName := range configNames {
+		possiblePath := filepath.Join(dir, configName)
+		if info, err := os.Stat(possiblePath); err == nil && !info.IsDir() {
+			return possiblePath
+		}
+	}
+	return ""
+}
+
+// xdgConfigNames are the names of config files in the XDG config directory.
+var xdgConfigNames = []string{"config.yml", "config.json", "config.toml"}
+
+// xdgConfigDir returns the phrase directory in the XDG config directory,
+// which defaults to ~/.config.
+func xdgConfigDir() string {
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home := defaultConfigDir()
+		if home == "" {
+			return ""
+		}
+		dir = filepath.Join(home, ".config")
+	}
+	return filepath.Join(dir, "phrase")
+}
+
+func isRepositoryRoot(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, ".git"))
+	return err == nil
+}
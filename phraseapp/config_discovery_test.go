@@ -0,0 +1,102 @@
+package phraseapp
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// emptyRepository returns a temporary directory marked as repository root,
+// so config discovery doesn't walk up any further.
+func emptyRepository(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "phraseapp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dir, err = filepath.EvalSymlinks(dir); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Mkdir(filepath.Join(dir, ".git"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestDiscoverConfigs(t *testing.T) {
+	repo := emptyRepository(t)
+	defer os.RemoveAll(repo)
+
+	files := []string{
+		".phrase.yml",
+		"apps/web/.phraseapp.yml",
+		"apps/web/src/main.go",
+		"apps/mobile/README.md",
+		"apps/mobile/.phrase.json",
+	}
+	for _, name := range files {
+		path := filepath.Join(repo, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			t.Fatal(err)
+		}
+		content := "phrase:\n  access_token: \"123\"\n"
+		if filepath.Ext(name) == ".json" {
+			content = `{"phrase": {"access_token": "123"}}`
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	home := emptyRepository(t)
+	defer os.RemoveAll(home)
+	ioutil.WriteFile(filepath.Join(home, ".phrase.yml"), []byte("phrase: {}\n"), 0600)
+	oldHome := os.Getenv("HOME")
+	os.Setenv("HOME", home)
+	defer os.Setenv("HOME", oldHome)
+
+	oldDir, _ := os.Getwd()
+	defer os.Chdir(oldDir)
+
+	tests := []struct {
+		dir   string
+		paths []string
+	}{
+		{"apps/web/src", []string{"apps/web/.phraseapp.yml", ".phrase.yml", "~/.phrase.yml"}},
+		{"apps/web", []string{"apps/web/.phraseapp.yml", ".phrase.yml", "~/.phrase.yml"}},
+		{"apps/mobile", []string{"apps/mobile/.phrase.json", ".phrase.yml", "~/.phrase.yml"}},
+		{".", []string{".phrase.yml", "~/.phrase.yml"}},
+	}
+	for _, tt := range tests {
+		if err := os.Chdir(filepath.Join(repo, tt.dir)); err != nil {
+			t.Fatal(err)
+		}
+		locations, err := DiscoverConfigs()
+		if err != nil {
+			t.Fatalf("%s: didn't expect an error, got %q", tt.dir, err)
+		}
+		if len(locations) != len(tt.paths) {
+			t.Errorf("%s: expected %d configs, got %v", tt.dir, len(tt.paths), locations)
+			continue
+		}
+		for i, path := range tt.paths {
+			exp := filepath.Join(repo, path)
+			if path[0] == '~' {
+				exp = filepath.Join(home, path[2:])
+			}
+			if locations[i].Path != exp {
+				t.Errorf("%s: expected config %d to be %q, got %q", tt.dir, i, exp, locations[i].Path)
+			}
+		}
+	}
+
+	os.Chdir(filepath.Join(repo, "apps/web/src"))
+	cfg, err := ReadConfig()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	exp := filepath.Join(repo, "apps/web/.phraseapp.yml") + " (in parent directory of working directory " + filepath.Join(repo, "apps/web/src") + ")"
+	if cfg.Token != "123" || cfg.Location.String() != exp {
+		t.Errorf("expected config from %q, got %q", exp, cfg.Location)
+	}
+}
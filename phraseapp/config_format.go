@@ -0,0 +1,55 @@
+package phraseapp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v2"
+)
+
+// configFormat returns the format of the config file at path by its
+// extension: "json" for .json, "toml" for .toml and "yaml" otherwise.
+func configFormat(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return "json"
+	case ".toml":
+		return "toml"
+	}
+	return "yaml"
+}
+
+// configContentAsYAML returns the content of the config file at path as
+// YAML, so files of all formats are read and validated alike. Syntax errors
+// name the line, as those of YAML do.
+func configContentAsYAML(path string, content []byte) ([]byte, error) {
+	var values map[string]interface{}
+	switch configFormat(path) {
+	case "json":
+		if err := json.Unmarshal(content, &values); err != nil {
+			if serr, ok := err.(*json.SyntaxError); ok {
+				return nil, fmt.Errorf("json: line %d: %s", lineOfOffset(content, serr.Offset), serr)
+			}
+			return nil, fmt.Errorf("json: %s", strings.TrimPrefix(err.Error(), "json: "))
+		}
+	case "toml":
+		if _, err := toml.Decode(string(content), &values); err != nil {
+			return nil, err
+		}
+	default:
+		return content, nil
+	}
+	return yaml.Marshal(values)
+}
+
+// lineOfOffset returns the line of the byte offset in content.
+func lineOfOffset(content []byte, offset int64) int {
+	if offset > int64(len(content)) {
+		offset = int64(len(content))
+	}
+	return bytes.Count(content[:offset], []byte("\n")) + 1
+}
@@ -0,0 +1,138 @@
+package phraseapp
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadConfigFileFormats(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		".phrase.yml": `phrase:
+  access_token: abc
+  project_id: def
+  per_page: 10
+  defaults:
+    locale/download:
+      file_format: yml
+      include_empty_translations: true
+  push:
+    sources:
+      - file: ./<locale_name>.yml
+`,
+		".phrase.json": `{
+	"phrase": {
+		"access_token": "abc",
+		"project_id": "def",
+		"per_page": 10,
+		"defaults": {
+			"locale/download": {"file_format": "yml", "include_empty_translations": true}
+		},
+		"push": {"sources": [{"file": "./<locale_name>.yml"}]}
+	}
+}
+`,
+		".phrase.toml": `[phrase]
+access_token = "abc"
+project_id = "def"
+per_page = 10
+
+[phrase.defaults."locale/download"]
+file_format = "yml"
+include_empty_translations = true
+
+[[phrase.push.sources]]
+file = "./<locale_name>.yml"
+`,
+	}
+
+	var exp *Config
+	for _, name := range []string{".phrase.yml", ".phrase.json", ".phrase.toml"} {
+		path := filepath.Join(dir, name)
+		ioutil.WriteFile(path, []byte(files[name]), 0600)
+
+		cfg, err := ReadConfigFile(path)
+		if err != nil {
+			t.Fatalf("%s: didn't expect an error, got %q", name, err)
+		}
+		cfg.Location, cfg.origins, cfg.read = nil, nil, nil
+		if exp == nil {
+			exp = cfg
+		} else if !reflect.DeepEqual(cfg, exp) {
+			t.Errorf("%s: expected config %+v, got %+v", name, exp, cfg)
+		}
+	}
+	if exp.Token != "abc" || *exp.PerPage != 10 || exp.Defaults["locale/download"]["include_empty_translations"] != true {
+		t.Errorf("unexpected config %+v", exp)
+	}
+}
+
+func TestReadConfigFileFormatErrors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// Invalid values are reported alike in all formats.
+	var exp string
+	for name, content := range map[string]string{
+		"a.yml":  "phrase:\n  per_page: many\n",
+		"a.json": `{"phrase": {"per_page": "many"}}`,
+		"a.toml": "[phrase]\nper_page = \"many\"\n",
+	} {
+		path := filepath.Join(dir, name)
+		ioutil.WriteFile(path, []byte(content), 0600)
+		_, err := ReadConfigFile(path)
+		if err == nil {
+			t.Fatalf("%s: expected an error", name)
+		}
+		msg := strings.TrimPrefix(err.Error(), path+": ")
+		if exp == "" {
+			exp = msg
+		} else if msg != exp {
+			t.Errorf("%s: expected error %q, got %q", name, exp, msg)
+		}
+	}
+
+	path := filepath.Join(dir, "b.json")
+	ioutil.WriteFile(path, []byte("{\n  \"phrase\": {\n    \"host\": ,\n  }\n}\n"), 0600)
+	if _, err := ReadConfigFile(path); err == nil || !strings.HasPrefix(err.Error(), path+": json: line 3: ") {
+		t.Errorf("expected a syntax error in line 3, got %v", err)
+	}
+	path = filepath.Join(dir, "b.toml")
+	ioutil.WriteFile(path, []byte("[phrase]\nhost = abc\n"), 0600)
+	if _, err := ReadConfigFile(path); err == nil || !strings.HasPrefix(err.Error(), path+": toml: line 2") {
+		t.Errorf("expected a syntax error in line 2, got %v", err)
+	}
+
+	if err := WriteConfig(filepath.Join(dir, "c.json"), &Config{}); err == nil || !strings.HasSuffix(err.Error(), "writing JSON config files isn't supported") {
+		t.Errorf("expected an unsupported format error, got %v", err)
+	}
+}
+
+func TestLintConfigFormats(t *testing.T) {
+	problems := LintConfigContent("a.json", []byte("{\n  \"phrase\": {\n    \"per_page\": \"many\"\n  }\n}\n"))
+	if exp := `a.json:3:17: phrase.per_page: expected integer, got string`; len(problems) != 1 || problems[0].String() != exp {
+		t.Errorf("expected problem %q, got %v", exp, problems)
+	}
+
+	problems = LintConfigContent("a.toml", []byte("[phrase]\nper_page = \"many\"\n"))
+	if exp := `a.toml: phrase.per_page: expected integer, got string`; len(problems) != 1 || problems[0].String() != exp {
+		t.Errorf("expected problem %q, got %v", exp, problems)
+	}
+
+	problems = LintConfigContent("a.toml", []byte("[phrase]\nhost = abc\n"))
+	if len(problems) != 1 || problems[0].Line != 2 || strings.HasPrefix(problems[0].Message, "toml:") {
+		t.Errorf("expected a syntax error in line 2, got %v", problems)
+	}
+}
@@ -0,0 +1,156 @@
+package phraseapp
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// skippedInitDirs are not searched for locale files by InitConfig.
+var skippedInitDirs = map[string]bool{"node_modules": true, "vendor": true}
+
+// InitConfig proposes a config for the project, e.g. to be written with
+// WriteConfig. The token and host are those of the client, the host only
+// if it isn't the default one. The file format
+// is the project's main format. Push sources and pull targets use the path
+// pattern of the existing locale files below dir, e.g.
+// "./config/locales/<locale_name>.yml" for config/locales/en.yml, or
+// "./locales/<locale_name>.<extension>" if there are none.
+func InitConfig(client *Client, projectID, dir string) (*Config, error) {
+	project, err := client.ProjectShow(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var locales []*Locale
+	for page := 1; ; page++ {
+		list, err := client.LocalesList(projectID, page, 100, &LocalesListParams{})
+		if err != nil {
+			return nil, err
+		}
+		locales = append(locales, list...)
+		if len(list) < 100 {
+			break
+		}
+	}
+
+	format := project.MainFormat
+	if format == "" {
+		return nil, fmt.Errorf("project %s has no main format", projectID)
+	}
+	extension := format
+	formats, err := client.formatRegistry().Formats()
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range formats {
+		if f.ApiName == format && f.Extension != "" {
+			extension = f.Extension
+		}
+	}
+
+	pattern, err := localeFilePattern(dir, extension, locales)
+	if err != nil {
+		return nil, err
+	}
+	if pattern == "" {
+		pattern = "./locales/<locale_name>." + extension
+	}
+
+	location := []map[string]interface{}{{
+		"file":   pattern,
+		"params": map[string]interface{}{"file_format": format},
+	}}
+	sources, err := yaml.Marshal(map[string]interface{}{"sources": location})
+	if err != nil {
+		return nil, err
+	}
+	targets, err := yaml.Marshal(map[string]interface{}{"targets": location})
+	if err != nil {
+		return nil, err
+	}
+
+	host := client.Credentials.Host
+	if host == defaultHost {
+		host = ""
+	}
+	return &Config{
+		Credentials:       Credentials{Token: client.Credentials.Token, Host: host},
+		DefaultProjectID:  project.ID,
+		DefaultFileFormat: format,
+		Sources:           sources,
+		Targets:           targets,
+	}, nil
+}
+
+// localeFilePattern returns the most common path pattern of the files below
+// dir with the extension and a locale's name or code in their path. Names
+// are preferred over codes.
+func localeFilePattern(dir, extension string, locales []*Locale) (string, error) {
+	placeholders := map[string]map[string]bool{"<locale_name>": {}, "<locale_code>": {}}
+	for _, l := range locales {
+		placeholders["<locale_name>"][l.Name] = true
+		placeholders["<locale_code>"][l.Code] = true
+	}
+
+	counts := map[string]int{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		name := info.Name()
+		if info.IsDir() {
+			if path != dir && (strings.HasPrefix(name, ".") || skippedInitDirs[name]) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(name) != "."+extension {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		for placeholder, values := range placeholders {
+			segments := strings.Split(filepath.ToSlash(strings.TrimSuffix(rel, "."+extension)), "/")
+			matched := false
+			for i, segment := range segments {
+				if segment != "" && values[segment] {
+					segments[i] = placeholder
+					matched = true
+				}
+			}
+			if matched {
+				counts["./"+strings.Join(segments, "/")+"."+extension]++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	patterns := make([]string, 0, len(counts))
+	for pattern := range counts {
+		patterns = append(patterns, pattern)
+	}
+	sort.Slice(patterns, func(i, j int) bool {
+		if counts[patterns[i]] != counts[patterns[j]] {
+			return counts[patterns[i]] > counts[patterns[j]]
+		}
+		if a, b := strings.Contains(patterns[i], "<locale_name>"), strings.Contains(patterns[j], "<locale_name>"); a != b {
+			return a
+		}
+		return patterns[i] < patterns[j]
+	})
+	if len(patterns) == 0 {
+		return "", nil
+	}
+	return patterns[0], nil
+}
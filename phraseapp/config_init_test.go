@@ -0,0 +1,79 @@
+package phraseapp
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestInitConfig(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/projects/p1":
+			io.WriteString(w, `{"id":"p1","name":"App","main_format":"nested_json"}`)
+		case "/v2/projects/p1/locales":
+			io.WriteString(w, `[{"id":"1","name":"en","code":"en-GB"},{"id":"2","name":"de","code":"de-DE"}]`)
+		case "/v2/formats":
+			io.WriteString(w, `[{"api_name":"yml","extension":"yml"},{"api_name":"nested_json","extension":"json"}]`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	client, _ := NewClient(Credentials{Host: server.URL, Token: "secret"}, false)
+
+	dir, err := ioutil.TempDir("", "phraseapp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, name := range []string{"web/i18n/en.json", "web/i18n/de.json", "web/i18n/fr.json", "web/i18n/en-GB.json", "package.json", "node_modules/x/en.json", "docs/de/index.json"} {
+		path := filepath.Join(dir, name)
+		os.MkdirAll(filepath.Dir(path), 0700)
+		ioutil.WriteFile(path, []byte("{}"), 0600)
+	}
+
+	cfg, err := InitConfig(client, "p1", dir)
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if cfg.Token != "secret" || cfg.Host != server.URL || cfg.DefaultProjectID != "p1" || cfg.DefaultFileFormat != "nested_json" {
+		t.Errorf("unexpected config %+v", cfg)
+	}
+	exp := "sources:\n- file: ./web/i18n/<locale_name>.json\n  params:\n    file_format: nested_json\n"
+	if string(cfg.Sources) != exp {
+		t.Errorf("expected sources %q, got %q", exp, cfg.Sources)
+	}
+
+	empty, _ := ioutil.TempDir("", "phraseapp")
+	defer os.RemoveAll(empty)
+	cfg, err = InitConfig(client, "p1", empty)
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if exp := "targets:\n- file: ./locales/<locale_name>.json\n  params:\n    file_format: nested_json\n"; string(cfg.Targets) != exp {
+		t.Errorf("expected targets %q, got %q", exp, cfg.Targets)
+	}
+
+	// The default host isn't written, so PHRASEAPP_HOST applies later on.
+	serverURL, _ := url.Parse(server.URL)
+	client, _ = NewClient(Credentials{Host: defaultHost, Token: "secret"}, false)
+	client.Use(func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			req.URL.Scheme, req.URL.Host = serverURL.Scheme, serverURL.Host
+			return next(req)
+		}
+	})
+	cfg, err = InitConfig(client, "p1", empty)
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if cfg.Host != "" {
+		t.Errorf("expected no host for the default one, got %q", cfg.Host)
+	}
+}
@@ -0,0 +1,146 @@
+package phraseapp
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var interpolationPattern = regexp.MustCompile(`\$\$|\$\{([A-Za-z_][A-Za-z0-9_]*)(:-[^}]*)?\}`)
+
+// secretConfigKeys are the keys of a config section, or of a profile, whose
+// values may be read from files and commands, see resolveConfigSecrets.
+var secretConfigKeys = map[string]bool{"access_token": true}
+
+// configCommandsEnvVar enables cmd: values if set to true.
+const configCommandsEnvVar = "PHRASEAPP_CONFIG_COMMANDS"
+
+// interpolateConfigValues replaces the string values of a config section,
+// including those nested in defaults, profiles, push and pull: ${VAR} is
+// replaced by the environment variable VAR, or by default for
+// ${VAR:-default} if VAR is unset or empty. $$ is a literal $.
+func interpolateConfigValues(values map[string]interface{}) {
+	for key, value := range values {
+		values[key] = interpolateConfigValue(value)
+	}
+}
+
+// resolveConfigSecrets reads the secrets of the merged values of config
+// files, i.e. the values of secretConfigKeys, from elsewhere: "file:<path>"
+// is replaced by the content of the file, relative to the directory of the
+// config file the value is from, and "cmd:<command>" by the output of the
+// command run by the shell, e.g. "cmd:pass show phrase". Surrounding
+// whitespace is trimmed. As config files are also read from parent
+// directories, commands are only run if the PHRASEAPP_CONFIG_COMMANDS
+// environment variable is set to true.
+//
+// Only the values of the selected profile are merged, so secrets of other
+// profiles aren't read.
+func resolveConfigSecrets(merged map[string]interface{}, origins map[string]*ConfigOrigin) error {
+	keys := make([]string, 0, len(secretConfigKeys))
+	for key := range secretConfigKeys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		s, ok := merged[key].(string)
+		origin := origins[key]
+		if !ok || origin == nil || origin.File == nil {
+			continue
+		}
+		value, err := resolveConfigSecret(s, filepath.Dir(origin.File.Path))
+		if err != nil {
+			return fmt.Errorf("%s: configuration key %q: %s", origin.File.Path, key, err)
+		}
+		merged[key] = value
+	}
+	return nil
+}
+
+// interpolateConfigValue replaces the environment variables of the strings
+// of a value, see interpolateConfigString.
+func interpolateConfigValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case string:
+		return interpolateConfigString(v)
+	case map[string]interface{}:
+		for key, nested := range v {
+			v[key] = interpolateConfigValue(nested)
+		}
+	case map[interface{}]interface{}:
+		for key, nested := range v {
+			v[key] = interpolateConfigValue(nested)
+		}
+	case []interface{}:
+		for i, nested := range v {
+			v[i] = interpolateConfigValue(nested)
+		}
+	}
+	return value
+}
+
+// interpolateConfigString replaces ${VAR}, ${VAR:-default} and $$.
+func interpolateConfigString(s string) string {
+	return interpolationPattern.ReplaceAllStringFunc(s, func(match string) string {
+		if match == "$$" {
+			return "$"
+		}
+		groups := interpolationPattern.FindStringSubmatch(match)
+		if value := os.Getenv(groups[1]); value != "" || groups[2] == "" {
+			return value
+		}
+		return strings.TrimPrefix(groups[2], ":-")
+	})
+}
+
+// resolveConfigSecret reads file: and cmd: values, see
+// resolveConfigSecrets. Other values are returned as they are.
+func resolveConfigSecret(s, dir string) (string, error) {
+	switch {
+	case strings.HasPrefix(s, "file:"):
+		path := strings.TrimPrefix(s, "file:")
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(content)), nil
+	case strings.HasPrefix(s, "cmd:"):
+		command := strings.TrimPrefix(s, "cmd:")
+		if enabled, _ := strconv.ParseBool(os.Getenv(configCommandsEnvVar)); !enabled {
+			return "", fmt.Errorf("not running command %q, set %s=true to allow commands in config files", command, configCommandsEnvVar)
+		}
+		return runConfigCommand(command, dir)
+	default:
+		return s, nil
+	}
+}
+
+func runConfigCommand(command, dir string) (string, error) {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+	stdout := new(bytes.Buffer)
+	stderr := new(bytes.Buffer)
+	cmd.Dir = dir
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("command %q failed: %s %s", command, err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
@@ -0,0 +1,132 @@
+package phraseapp
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestInterpolateConfigString(t *testing.T) {
+	os.Setenv("PHRASE_TEST_LOCALE", "de")
+	defer os.Unsetenv("PHRASE_TEST_LOCALE")
+	os.Unsetenv("PHRASE_TEST_UNSET")
+
+	tests := []struct {
+		in, exp string
+	}{
+		{"./locales/${PHRASE_TEST_LOCALE}.yml", "./locales/de.yml"},
+		{"${PHRASE_TEST_UNSET}", ""},
+		{"${PHRASE_TEST_UNSET:-en}", "en"},
+		{"${PHRASE_TEST_LOCALE:-en}", "de"},
+		{"$${PHRASE_TEST_LOCALE} costs $5", "${PHRASE_TEST_LOCALE} costs $5"},
+		{"file:token", "file:token"},
+		{"literal", "literal"},
+	}
+	for _, tt := range tests {
+		if got := interpolateConfigString(tt.in); got != tt.exp {
+			t.Errorf("%s: expected %q, got %q", tt.in, tt.exp, got)
+		}
+	}
+}
+
+func TestResolveConfigSecret(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	ioutil.WriteFile(filepath.Join(dir, "token"), []byte("file-token\n"), 0600)
+	os.Setenv("PHRASE_TEST_LOCALE", "de")
+	defer os.Unsetenv("PHRASE_TEST_LOCALE")
+
+	if _, err := resolveConfigSecret("cmd:echo secret", dir); err == nil || !strings.Contains(err.Error(), "set PHRASEAPP_CONFIG_COMMANDS=true") {
+		t.Errorf("expected commands to be disabled, got %v", err)
+	}
+	os.Setenv("PHRASEAPP_CONFIG_COMMANDS", "true")
+	defer os.Unsetenv("PHRASEAPP_CONFIG_COMMANDS")
+
+	tests := []struct {
+		in, exp string
+	}{
+		{"file:token", "file-token"},
+		{"file:" + filepath.Join(dir, "token"), "file-token"},
+		{"cmd:echo cmd-token-${PHRASE_TEST_LOCALE}", "cmd-token-de"},
+		{"abc", "abc"},
+	}
+	for _, tt := range tests {
+		got, err := resolveConfigSecret(tt.in, dir)
+		if err != nil {
+			t.Errorf("%s: didn't expect an error, got %q", tt.in, err)
+		} else if got != tt.exp {
+			t.Errorf("%s: expected %q, got %q", tt.in, tt.exp, got)
+		}
+	}
+
+	if _, err := resolveConfigSecret("file:missing", dir); err == nil {
+		t.Errorf("expected an error for a missing file")
+	}
+	if _, err := resolveConfigSecret("cmd:echo oops >&2; exit 3", dir); err == nil || !strings.Contains(err.Error(), "oops") {
+		t.Errorf("expected an error with the command's output, got %v", err)
+	}
+}
+
+func TestReadConfigInterpolation(t *testing.T) {
+	repo := emptyRepository(t)
+	defer os.RemoveAll(repo)
+	ioutil.WriteFile(filepath.Join(repo, ".phrase.yml"), []byte(`phrase:
+  access_token: "cmd:echo secret"
+  project_id: ${PHRASE_TEST_PROJECT:-fallback}
+  host: "cmd:echo https://evil.example.com"
+  profiles:
+    us:
+      access_token: "cmd:echo us-secret"
+    eu:
+      access_token: file:missing-token
+  defaults:
+    locales/download:
+      file_format: ${PHRASE_TEST_FORMAT}
+  push:
+    sources:
+      - file: ./locales/${PHRASE_TEST_FORMAT}/<locale_name>.yml
+`), 0600)
+
+	oldDir, _ := os.Getwd()
+	os.Chdir(repo)
+	defer os.Chdir(oldDir)
+	oldHome := os.Getenv("HOME")
+	os.Setenv("HOME", repo)
+	defer os.Setenv("HOME", oldHome)
+	os.Setenv("PHRASE_TEST_FORMAT", "yml")
+	defer os.Unsetenv("PHRASE_TEST_FORMAT")
+
+	if _, err := ReadConfig(); err == nil || !strings.Contains(err.Error(), "not running command") {
+		t.Fatalf("expected commands to be disabled, got %v", err)
+	}
+	os.Setenv("PHRASEAPP_CONFIG_COMMANDS", "true")
+	defer os.Unsetenv("PHRASEAPP_CONFIG_COMMANDS")
+
+	cfg, err := ReadConfig()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	// Only secrets are read from commands.
+	if cfg.Host != "cmd:echo https://evil.example.com" {
+		t.Errorf("expected the host to be kept, got %q", cfg.Host)
+	}
+	// Secrets of profiles are only read if they are selected.
+	us, err := ReadConfigWithOverrides(map[string]interface{}{"profile": "us"})
+	if err != nil || us.Token != "us-secret" {
+		t.Errorf("expected the profile's token to be read from the command, got %v (%v)", us, err)
+	}
+	if _, err := ReadConfigWithOverrides(map[string]interface{}{"profile": "eu"}); err == nil || !strings.Contains(err.Error(), `configuration key "access_token": open `) {
+		t.Errorf("expected an error for the missing token file, got %v", err)
+	}
+	if cfg.Token != "secret" || cfg.DefaultProjectID != "fallback" || cfg.Defaults["locales/download"]["file_format"] != "yml" {
+		t.Errorf("expected the values to be interpolated, got %q %q %v", cfg.Token, cfg.DefaultProjectID, cfg.Defaults)
+	}
+	if !strings.Contains(string(cfg.Sources), "./locales/yml/<locale_name>.yml") {
+		t.Errorf("expected the push paths to be interpolated, got %s", cfg.Sources)
+	}
+}
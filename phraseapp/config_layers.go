@@ -0,0 +1,302 @@
+package phraseapp
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+
+	"gopkg.in/yaml.v2"
+)
+
+// configEnvVars maps environment variables to the config keys they set.
+var configEnvVars = []struct {
+	Name, Key string
+}{
+	{"PHRASEAPP_ACCESS_TOKEN", "access_token"},
+	{"PHRASEAPP_HOST", "host"},
+	{"PHRASEAPP_PROJECT_ID", "project_id"},
+	{"PHRASEAPP_FILE_FORMAT", "file_format"},
+	{"PHRASEAPP_DEBUG", "debug"},
+	{"PHRASEAPP_PAGE", "page"},
+	{"PHRASEAPP_PER_PAGE", "per_page"},
+	{"PHRASEAPP_PROFILE", "profile"},
+}
+
+// ConfigOrigin tells where a config value came from, see Config.Origin.
+type ConfigOrigin struct {
+	// File is set for values of a config file.
+	File *ConfigLocation
+	// Variable is set for values of an environment variable.
+	Variable string
+	// Override is set for values passed to ReadConfigWithOverrides.
+	Override bool
+}
+
+func (o *ConfigOrigin) String() string {
+	switch {
+	case o.File != nil:
+		return o.File.String()
+	case o.Variable != "":
+		return "environment variable " + o.Variable
+	default:
+		return "override"
+	}
+}
+
+// ReadConfigWithOverrides reads the config from all layers, each one
+// overriding the values of the ones before:
+//
+//  1. the config files of DiscoverConfigs, the one taking precedence last
+//  2. the selected profile of the config files, see below
+//  3. the PHRASEAPP_* environment variables, e.g. PHRASEAPP_PROJECT_ID
+//  4. overrides, keyed like the config file, e.g. "project_id"
+//
+// A global token in ~/.phrase.yml thereby combines with the project_id of a
+// project's .phrase.yml. Defaults are merged per path and parameter. Use
+// Config.Origin to find out where a value came from.
+//
+// Profiles are named sets of values in the profiles section of the config
+// files, e.g. for accounts on different hosts:
+//
+//	phrase:
+//	  profile: eu
+//	  profiles:
+//	    eu:
+//	      access_token: abc
+//	    us:
+//	      access_token: def
+//	      host: https://api.us.app.phrase.com
+//
+// The profile is selected by the "profile" override, the PHRASEAPP_PROFILE
+// environment variable or the profile key of the config files, in this
+// order. It is an error if the selected profile doesn't exist.
+func ReadConfigWithOverrides(overrides map[string]interface{}) (*Config, error) {
+	locations, err := DiscoverConfigs()
+	if err != nil {
+		return nil, err
+	}
+
+	merged := map[string]interface{}{}
+	origins := map[string]*ConfigOrigin{}
+	for i := len(locations) - 1; i >= 0; i-- {
+		values, err := readConfigValues(locations[i].Path)
+		if err != nil {
+			return nil, err
+		}
+		mergeConfigValues(merged, origins, "", values, fixedOrigin(&ConfigOrigin{File: locations[i]}))
+	}
+
+	if err := applyProfile(merged, origins, selectedProfile(merged, overrides)); err != nil {
+		return nil, err
+	}
+	if err := resolveConfigSecrets(merged, origins); err != nil {
+		return nil, err
+	}
+
+	for _, env := range configEnvVars {
+		raw, found := os.LookupEnv(env.Name)
+		if !found || raw == "" {
+			continue
+		}
+		value, err := parseConfigEnv(env.Key, raw)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %s", env.Name, err)
+		}
+		mergeConfigValues(merged, origins, "", map[string]interface{}{env.Key: value}, fixedOrigin(&ConfigOrigin{Variable: env.Name}))
+	}
+
+	mergeConfigValues(merged, origins, "", overrides, fixedOrigin(&ConfigOrigin{Override: true}))
+
+	cfg, err := configFromValues(merged)
+	if err != nil {
+		return nil, err
+	}
+	if len(locations) > 0 {
+		cfg.Location = locations[0]
+	}
+	cfg.origins = origins
+	return cfg, nil
+}
+
+// configFromValues returns the config of the values of a phrase section.
+// They are round tripped through YAML, so they are validated like a file.
+func configFromValues(values map[string]interface{}) (*Config, error) {
+	content, err := yaml.Marshal(map[string]interface{}{"phrase": values})
+	if err != nil {
+		return nil, err
+	}
+	rawCfg := map[string]*Config{}
+	if err := yaml.Unmarshal(content, rawCfg); err != nil {
+		return nil, err
+	}
+
+	if cfg := rawCfg["phrase"]; cfg != nil {
+		return cfg, nil
+	}
+	return &Config{}, nil
+}
+
+// Origin returns where the value of the config key came from, or nil if it
+// isn't set. Keys are named as in the config file, e.g. "project_id", and
+// defaults as "defaults.<path>.<parameter>".
+func (cfg *Config) Origin(key string) *ConfigOrigin {
+	return cfg.origins[key]
+}
+
+// readConfigValues returns the values of the phrase section of a YAML, JSON
+// or TOML config file, interpolated by interpolateConfigValues. Secrets
+// aren't resolved, see resolveConfigSecrets.
+func readConfigValues(path string) (map[string]interface{}, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err = configContentAsYAML(path, content)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+	raw := map[string]interface{}{}
+	if err := yaml.Unmarshal(content, raw); err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+
+	section, found := raw["phrase"]
+	if !found {
+		section, found = raw["phraseapp"]
+	}
+	switch {
+	case !found:
+		return nil, fmt.Errorf("%s: 'phrase' key is missing in config", path)
+	case section == nil:
+		return map[string]interface{}{}, nil
+	}
+
+	values, err := rawConfigValues("phrase", section)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+	interpolateConfigValues(values)
+	return values, nil
+}
+
+// rawConfigValues converts the YAML maps of a config section, including
+// those of defaults and profiles, to string keyed maps.
+func rawConfigValues(key string, section interface{}) (map[string]interface{}, error) {
+	values, err := ValidateIsRawMap(key, section)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, nested := range []string{"defaults", "profiles"} {
+		raw, found := values[nested]
+		if !found || raw == nil {
+			continue
+		}
+		entries, err := ValidateIsRawMap(nested, raw)
+		if err != nil {
+			return nil, err
+		}
+		for name, entry := range entries {
+			if nested == "defaults" {
+				entries[name], err = ValidateIsRawMap("defaults."+name, entry)
+			} else {
+				entries[name], err = rawConfigValues("profiles."+name, entry)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		values[nested] = entries
+	}
+	return values, nil
+}
+
+// mergeConfigValues sets values in merged, replacing existing ones except
+// for defaults and profiles, which are merged per entry and key. The origins
+// of the values are recorded by their key prefixed with prefix, and looked
+// up by origin.
+func mergeConfigValues(merged map[string]interface{}, origins map[string]*ConfigOrigin, prefix string, values map[string]interface{}, origin func(key string) *ConfigOrigin) {
+	for key, value := range values {
+		entries, ok := value.(map[string]interface{})
+		if (key != "defaults" && key != "profiles") || !ok {
+			merged[key] = value
+			origins[prefix+key] = origin(key)
+			continue
+		}
+
+		mergedEntries, _ := merged[key].(map[string]interface{})
+		if mergedEntries == nil {
+			mergedEntries = map[string]interface{}{}
+			merged[key] = mergedEntries
+		}
+		for name, entry := range entries {
+			mergedEntry, _ := mergedEntries[name].(map[string]interface{})
+			if mergedEntry == nil {
+				mergedEntry = map[string]interface{}{}
+				mergedEntries[name] = mergedEntry
+			}
+			entry, _ := entry.(map[string]interface{})
+			entryKey := key + "." + name + "."
+			if key == "profiles" {
+				mergeConfigValues(mergedEntry, origins, prefix+entryKey, entry, func(k string) *ConfigOrigin {
+					return origin(entryKey + k)
+				})
+				continue
+			}
+			for k, v := range entry {
+				mergedEntry[k] = v
+				origins[prefix+entryKey+k] = origin(entryKey + k)
+			}
+		}
+	}
+}
+
+// selectedProfile returns the name of the profile selected by overrides,
+// environment or config files.
+func selectedProfile(merged, overrides map[string]interface{}) string {
+	if name, ok := overrides["profile"].(string); ok && name != "" {
+		return name
+	}
+	if name := os.Getenv("PHRASEAPP_PROFILE"); name != "" {
+		return name
+	}
+	name, _ := merged["profile"].(string)
+	return name
+}
+
+// applyProfile merges the values of the named profile into merged and
+// removes the profiles section.
+func applyProfile(merged map[string]interface{}, origins map[string]*ConfigOrigin, name string) error {
+	profiles, _ := merged["profiles"].(map[string]interface{})
+	delete(merged, "profiles")
+	if name == "" {
+		return nil
+	}
+
+	profile, found := profiles[name].(map[string]interface{})
+	if !found {
+		return fmt.Errorf("profile %q not found in config", name)
+	}
+	prefix := "profiles." + name + "."
+	mergeConfigValues(merged, origins, "", profile, func(key string) *ConfigOrigin {
+		return origins[prefix+key]
+	})
+	return nil
+}
+
+func fixedOrigin(origin *ConfigOrigin) func(string) *ConfigOrigin {
+	return func(string) *ConfigOrigin { return origin }
+}
+
+func parseConfigEnv(key, raw string) (interface{}, error) {
+	switch key {
+	case "debug":
+		return strconv.ParseBool(raw)
+	case "page", "per_page":
+		return strconv.Atoi(raw)
+	default:
+		return raw, nil
+	}
+}
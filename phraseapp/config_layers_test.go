@@ -0,0 +1,167 @@
+package phraseapp
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadConfigWithOverrides(t *testing.T) {
+	repo := emptyRepository(t)
+	defer os.RemoveAll(repo)
+	home := emptyRepository(t)
+	defer os.RemoveAll(home)
+
+	files := map[string]string{
+		filepath.Join(home, ".phrase.yml"): `phrase:
+  access_token: home-token
+  project_id: home-project
+  defaults:
+    locales/download:
+      file_format: yml
+      encoding: UTF-8
+`,
+		filepath.Join(home, ".config/phrase/config.yml"): `phrase:
+  host: https://api.example.com
+`,
+		filepath.Join(repo, ".phrase.yml"): `phrase:
+  project_id: repo-project
+  defaults:
+    locales/download:
+      file_format: json
+`,
+	}
+	for path, content := range files {
+		os.MkdirAll(filepath.Dir(path), 0700)
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	oldDir, _ := os.Getwd()
+	os.Chdir(repo)
+	defer os.Chdir(oldDir)
+	oldHome := os.Getenv("HOME")
+	os.Setenv("HOME", home)
+	defer os.Setenv("HOME", oldHome)
+	os.Setenv("PHRASEAPP_PER_PAGE", "50")
+	defer os.Unsetenv("PHRASEAPP_PER_PAGE")
+
+	cfg, err := ReadConfigWithOverrides(map[string]interface{}{"debug": true})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+
+	if cfg.Token != "home-token" || cfg.Host != "https://api.example.com" || cfg.DefaultProjectID != "repo-project" {
+		t.Errorf("expected the files to be merged, got %+v", cfg.Credentials)
+	}
+	if cfg.PerPage == nil || *cfg.PerPage != 50 || !cfg.Debug {
+		t.Errorf("expected the environment and overrides to be applied, got %v %t", cfg.PerPage, cfg.Debug)
+	}
+	download := cfg.Defaults["locales/download"]
+	if download["file_format"] != "json" || download["encoding"] != "UTF-8" {
+		t.Errorf("expected the defaults to be merged, got %v", download)
+	}
+
+	origins := map[string]string{
+		"access_token":                       filepath.Join(home, ".phrase.yml") + " (in home directory)",
+		"host":                               filepath.Join(home, ".config/phrase/config.yml") + " (in XDG config directory)",
+		"project_id":                         filepath.Join(repo, ".phrase.yml") + " (in working directory)",
+		"defaults.locales/download.encoding": filepath.Join(home, ".phrase.yml") + " (in home directory)",
+		"per_page":                           "environment variable PHRASEAPP_PER_PAGE",
+		"debug":                              "override",
+	}
+	for key, exp := range origins {
+		if origin := cfg.Origin(key); origin == nil || origin.String() != exp {
+			t.Errorf("expected %s to come from %q, got %v", key, exp, origin)
+		}
+	}
+	if cfg.Origin("page") != nil {
+		t.Errorf("expected no origin for an unset key")
+	}
+
+	os.Setenv("PHRASEAPP_PER_PAGE", "many")
+	if _, err := ReadConfig(); err == nil {
+		t.Errorf("expected an error for an invalid environment variable")
+	}
+}
+
+func TestConfigProfiles(t *testing.T) {
+	repo := emptyRepository(t)
+	defer os.RemoveAll(repo)
+	home := emptyRepository(t)
+	defer os.RemoveAll(home)
+
+	files := map[string]string{
+		filepath.Join(home, ".phrase.yml"): `phrase:
+  profile: eu
+  profiles:
+    eu:
+      access_token: eu-token
+    us:
+      access_token: us-token
+      host: https://api.us.app.phrase.com
+      defaults:
+        locales/download:
+          encoding: UTF-8
+`,
+		filepath.Join(repo, ".phrase.yml"): `phrase:
+  project_id: repo-project
+  profiles:
+    us:
+      project_id: us-project
+`,
+	}
+	for path, content := range files {
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	oldDir, _ := os.Getwd()
+	os.Chdir(repo)
+	defer os.Chdir(oldDir)
+	oldHome := os.Getenv("HOME")
+	os.Setenv("HOME", home)
+	defer os.Setenv("HOME", oldHome)
+
+	cfg, err := ReadConfig()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if cfg.Profile != "eu" || cfg.Token != "eu-token" || cfg.Host != "" || cfg.DefaultProjectID != "repo-project" {
+		t.Errorf("expected the default profile eu, got %q %+v %q", cfg.Profile, cfg.Credentials, cfg.DefaultProjectID)
+	}
+
+	os.Setenv("PHRASEAPP_PROFILE", "us")
+	defer os.Unsetenv("PHRASEAPP_PROFILE")
+	cfg, err = ReadConfig()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if cfg.Profile != "us" || cfg.Token != "us-token" || cfg.Host != "https://api.us.app.phrase.com" || cfg.DefaultProjectID != "us-project" {
+		t.Errorf("expected the profile us, got %q %+v %q", cfg.Profile, cfg.Credentials, cfg.DefaultProjectID)
+	}
+	if cfg.Defaults["locales/download"]["encoding"] != "UTF-8" {
+		t.Errorf("expected the profile's defaults, got %v", cfg.Defaults)
+	}
+	if origin := cfg.Origin("project_id"); origin == nil || origin.File.Path != filepath.Join(repo, ".phrase.yml") {
+		t.Errorf("expected project_id to come from the project's profile, got %v", origin)
+	}
+	if origin := cfg.Origin("defaults.locales/download.encoding"); origin == nil || origin.File.Path != filepath.Join(home, ".phrase.yml") {
+		t.Errorf("expected the default to come from the home profile, got %v", origin)
+	}
+
+	client, err := NewClientForProfile("eu")
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if client.Credentials.Token != "eu-token" || client.Credentials.Host != "https://api.phrase.com" {
+		t.Errorf("expected a client for the profile eu, got %+v", client.Credentials)
+	}
+
+	if _, err := NewClientForProfile("apac"); err == nil || err.Error() != `profile "apac" not found in config` {
+		t.Errorf("expected an error for an unknown profile, got %v", err)
+	}
+}
@@ -0,0 +1,381 @@
+package phraseapp
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// LintProblem is a problem of a config file found by LintConfig.
+type LintProblem struct {
+	File   string
+	Line   int
+	Column int
+	// Key is the path of the offending key, e.g.
+	// "phrase.defaults.locale/download.file_format".
+	Key     string
+	Message string
+}
+
+// String returns the problem as "file:line:column: key: message". The
+// position is left out if unknown, as for TOML files.
+func (p *LintProblem) String() string {
+	if p.Line == 0 {
+		if p.Key == "" {
+			return fmt.Sprintf("%s: %s", p.File, p.Message)
+		}
+		return fmt.Sprintf("%s: %s: %s", p.File, p.Key, p.Message)
+	}
+	if p.Key == "" {
+		return fmt.Sprintf("%s:%d:%d: %s", p.File, p.Line, p.Column, p.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s: %s", p.File, p.Line, p.Column, p.Key, p.Message)
+}
+
+// LintConfig checks the config file at path against the schema of
+// ConfigJSONSchema and returns all problems found, in the order of the
+// file. The keys of defaults blocks are checked against the params of the
+// command. Only reading the file may fail.
+func LintConfig(path string) ([]*LintProblem, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return LintConfigContent(path, content), nil
+}
+
+var yamlErrLinePattern = regexp.MustCompile(`line (\d+)`)
+
+// LintConfigContent checks the content of a config file, see LintConfig.
+// file is only used for the problems.
+func LintConfigContent(file string, content []byte) []*LintProblem {
+	l := &configLinter{file: file}
+
+	// JSON is YAML, so JSON files are linted as they are, with positions.
+	// TOML files are linted as converted to YAML, without.
+	converted, err := configContentAsYAML(file, content)
+	if err != nil {
+		return []*LintProblem{syntaxProblem(file, err)}
+	}
+	positions := configFormat(file) != "toml"
+	if !positions {
+		content = converted
+	}
+
+	var doc yamlv3.Node
+	if err := yamlv3.Unmarshal(content, &doc); err != nil {
+		return []*LintProblem{syntaxProblem(file, err)}
+	}
+
+	if len(doc.Content) == 0 {
+		return []*LintProblem{{File: file, Line: 1, Column: 1, Message: "'phrase' key is missing in config"}}
+	}
+	root := resolveYAMLAlias(doc.Content[0])
+	if root.Kind == yamlv3.MappingNode && yamlMappingValue(root, "phrase") == nil && yamlMappingValue(root, "phraseapp") == nil {
+		l.add(root, "", "'phrase' key is missing in config")
+	}
+	l.lint(root, configFileSchema(), "")
+	if !positions {
+		for _, p := range l.problems {
+			p.Line, p.Column = 0, 0
+		}
+	}
+	return l.problems
+}
+
+var syntaxErrPrefixPattern = regexp.MustCompile(`^(yaml|json|toml): (line \d+( \([^)]*\))?: )?`)
+
+// syntaxProblem returns the problem of a YAML, JSON or TOML syntax error.
+func syntaxProblem(file string, err error) *LintProblem {
+	line := 1
+	if m := yamlErrLinePattern.FindStringSubmatch(err.Error()); m != nil {
+		line, _ = strconv.Atoi(m[1])
+	}
+	msg := syntaxErrPrefixPattern.ReplaceAllString(err.Error(), "")
+	return &LintProblem{File: file, Line: line, Column: 1, Message: msg}
+}
+
+type configLinter struct {
+	file     string
+	problems []*LintProblem
+}
+
+func (l *configLinter) add(node *yamlv3.Node, key, msg string) {
+	l.problems = append(l.problems, &LintProblem{File: l.file, Line: node.Line, Column: node.Column, Key: key, Message: msg})
+}
+
+func (l *configLinter) lint(node *yamlv3.Node, schema *configSchema, key string) {
+	node = resolveYAMLAlias(node)
+	typ := yamlNodeType(node)
+	if typ == "null" && schema.Nullable {
+		return
+	}
+	if !schema.allows(typ) {
+		l.add(node, key, fmt.Sprintf("expected %s, got %s", schema.typeName(), typ))
+		return
+	}
+
+	switch node.Kind {
+	case yamlv3.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			keyNode, valueNode := node.Content[i], node.Content[i+1]
+			name := keyNode.Value
+			path := name
+			if key != "" {
+				path = key + "." + name
+			}
+
+			if property, found := schema.Properties[name]; found {
+				l.lint(valueNode, property, path)
+			} else if additional, ok := schema.AdditionalProperties.(*configSchema); ok {
+				l.lint(valueNode, additional, path)
+			} else if schema.unknownKey != "" {
+				l.add(keyNode, path, fmt.Sprintf(schema.unknownKey, name))
+			} else {
+				l.add(keyNode, path, fmt.Sprintf("unknown key %q", name))
+			}
+		}
+	case yamlv3.SequenceNode:
+		for i, item := range node.Content {
+			l.lint(item, schema.Items, fmt.Sprintf("%s[%d]", key, i))
+		}
+	}
+}
+
+func resolveYAMLAlias(node *yamlv3.Node) *yamlv3.Node {
+	for node.Kind == yamlv3.AliasNode && node.Alias != nil {
+		node = node.Alias
+	}
+	return node
+}
+
+func yamlMappingValue(node *yamlv3.Node, key string) *yamlv3.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// yamlNodeType returns the JSON Schema type of a node.
+func yamlNodeType(node *yamlv3.Node) string {
+	switch node.Kind {
+	case yamlv3.MappingNode:
+		return "object"
+	case yamlv3.SequenceNode:
+		return "array"
+	}
+	switch node.ShortTag() {
+	case "!!bool":
+		return "boolean"
+	case "!!int":
+		return "integer"
+	case "!!float":
+		return "number"
+	case "!!null":
+		return "null"
+	default:
+		return "string"
+	}
+}
+
+// configSchema describes the structure of config files. It is rendered as
+// JSON Schema by ConfigJSONSchema and checked by LintConfig.
+type configSchema struct {
+	Schema      string      `json:"$schema,omitempty"`
+	Title       string      `json:"title,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Type        interface{} `json:"type,omitempty"`
+	Format      string      `json:"format,omitempty"`
+
+	Properties map[string]*configSchema `json:"properties,omitempty"`
+	// AdditionalProperties is false or the *configSchema of properties not
+	// listed in Properties.
+	AdditionalProperties interface{}   `json:"additionalProperties,omitempty"`
+	Items                *configSchema `json:"items,omitempty"`
+
+	// Nullable allows empty values, e.g. of an empty phrase section.
+	Nullable bool `json:"-"`
+	// unknownKey is the message format for keys not allowed.
+	unknownKey string
+}
+
+func (s *configSchema) types() []string {
+	switch t := s.Type.(type) {
+	case string:
+		return []string{t}
+	case []string:
+		return t
+	default:
+		return nil
+	}
+}
+
+func (s *configSchema) allows(typ string) bool {
+	types := s.types()
+	for _, t := range types {
+		if t == typ || (t == "number" && typ == "integer") {
+			return true
+		}
+	}
+	return len(types) == 0
+}
+
+func (s *configSchema) typeName() string {
+	return strings.Join(s.types(), " or ")
+}
+
+func schemaOf(typ, description string) *configSchema {
+	return &configSchema{Type: typ, Description: description}
+}
+
+func objectSchema(description string, properties map[string]*configSchema) *configSchema {
+	return &configSchema{Type: "object", Description: description, Properties: properties, AdditionalProperties: false}
+}
+
+// ConfigJSONSchema returns the JSON Schema of .phrase.yml config files, e.g.
+// for editor support.
+func ConfigJSONSchema() ([]byte, error) {
+	schema := configFileSchema()
+	schema.Schema = "http://json-schema.org/draft-07/schema#"
+	schema.Title = "Phrase config file"
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+func configFileSchema() *configSchema {
+	section := configSectionSchema(true)
+	return objectSchema("", map[string]*configSchema{
+		"phrase":    section,
+		"phraseapp": section,
+	})
+}
+
+func configSectionSchema(withProfiles bool) *configSchema {
+	location := func(params *configSchema) *configSchema {
+		return objectSchema("", map[string]*configSchema{
+			"file":         schemaOf("string", "Path of the locale files, with placeholders like <locale_name>"),
+			"project_id":   schemaOf("string", "Project of the files, defaults to project_id"),
+			"access_token": schemaOf("string", "Access token for the files, defaults to access_token"),
+			"params":       params,
+		})
+	}
+
+	pullParams := paramsSchema(reflect.TypeOf(LocaleDownloadParams{}))
+	pullParams.Properties["locale_id"] = schemaOf("string", "")
+
+	properties := map[string]*configSchema{
+		"access_token": schemaOf("string", "Access token used for authentication"),
+		"host":         schemaOf("string", "Host to send requests to"),
+		"debug":        schemaOf("boolean", "Verbose output"),
+		"page":         schemaOf("integer", "Page of list requests"),
+		"per_page":     schemaOf("integer", "Page size of list requests"),
+		"project_id":   schemaOf("string", "Default project"),
+		"file_format":  schemaOf("string", "Default file format"),
+		"defaults":     defaultsSchema(),
+		"push": objectSchema("Files uploaded by push", map[string]*configSchema{
+			"sources": {Type: "array", Items: location(paramsSchema(reflect.TypeOf(UploadParams{})))},
+		}),
+		"pull": objectSchema("Files downloaded by pull", map[string]*configSchema{
+			"targets": {Type: "array", Items: location(pullParams)},
+		}),
+	}
+	if withProfiles {
+		properties["profile"] = schemaOf("string", "Profile selected unless overridden")
+		properties["profiles"] = &configSchema{
+			Type:                 "object",
+			Description:          "Named sets of values overriding the ones above",
+			AdditionalProperties: configSectionSchema(false),
+		}
+	}
+
+	section := objectSchema("", properties)
+	section.Nullable = true
+	return section
+}
+
+func defaultsSchema() *configSchema {
+	properties := map[string]*configSchema{}
+	for command, typ := range paramsCommands {
+		properties[command] = paramsSchema(typ)
+	}
+	schema := objectSchema("Default params per command", properties)
+	schema.unknownKey = "unknown command %q"
+	return schema
+}
+
+// paramsSchema returns the schema of the keys ApplyValuesFromMap accepts
+// for the params type.
+func paramsSchema(typ reflect.Type) *configSchema {
+	properties := map[string]*configSchema{}
+	for _, key := range paramsKeys(typ) {
+		field, _ := paramsField(typ, key)
+		properties[key] = fieldSchema(field.Type)
+	}
+	schema := objectSchema("", properties)
+	schema.unknownKey = fmt.Sprintf("unknown parameter %%q of %s", typ.Name())
+	return schema
+}
+
+func fieldSchema(typ reflect.Type) *configSchema {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	switch {
+	case typ == reflect.TypeOf(time.Time{}):
+		return &configSchema{Type: "string", Format: "date-time"}
+	case typ.Kind() == reflect.Bool:
+		return &configSchema{Type: "boolean"}
+	case typ.Kind() >= reflect.Int && typ.Kind() <= reflect.Int64:
+		return &configSchema{Type: "integer"}
+	case typ == reflect.TypeOf(WebhookEvents{}):
+		// Joined with commas or a list, see WebhookParams.ApplyValuesFromMap.
+		return &configSchema{Type: []string{"string", "array"}, Items: &configSchema{Type: "string"}}
+	case typ.Kind() == reflect.Slice:
+		return &configSchema{Type: "array", Items: fieldSchema(typ.Elem())}
+	case typ.Kind() == reflect.Map:
+		// See ConvertToStringMap.
+		return &configSchema{Type: "object", AdditionalProperties: &configSchema{Type: []string{"string", "boolean", "integer"}}}
+	default:
+		return &configSchema{Type: "string"}
+	}
+}
+
+// paramsKeys returns the keys ApplyValuesFromMap of the params type
+// accepts, i.e. those not rejected as unknown.
+func paramsKeys(typ reflect.Type) []string {
+	var keys []string
+	for i := 0; i < typ.NumField(); i++ {
+		key := strings.Split(typ.Field(i).Tag.Get("json"), ",")[0]
+		if key == "" || key == "-" {
+			continue
+		}
+		params := reflect.New(typ).Interface().(interface {
+			ApplyValuesFromMap(map[string]interface{}) error
+		})
+		// The probe value has a type no key accepts.
+		err := params.ApplyValuesFromMap(map[string]interface{}{key: struct{}{}})
+		if err == nil || err.Error() != fmt.Sprintf(cfgInvalidKeyErrStr, key) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func paramsField(typ reflect.Type, key string) (reflect.StructField, bool) {
+	for i := 0; i < typ.NumField(); i++ {
+		if strings.Split(typ.Field(i).Tag.Get("json"), ",")[0] == key {
+			return typ.Field(i), true
+		}
+	}
+	return reflect.StructField{}, false
+}
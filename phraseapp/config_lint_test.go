@@ -0,0 +1,124 @@
+package phraseapp
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestLintConfig(t *testing.T) {
+	content := `phrase:
+  access_token: abc
+  per_page: many
+  projct_id: x
+  defaults:
+    locale/download:
+      file_format: yml
+      encoding: UTF-8
+      include_empty_translations: "yes"
+      colour: red
+    locale/upload:
+      file_format: yml
+  push:
+    sources:
+      - file: ./locales/<locale_name>.yml
+        params:
+          file_format: yml
+          locale_mapping:
+            en: 1
+      - file: ./other.yml
+        branch: main
+  profiles:
+    us:
+      host: 1
+`
+	problems := LintConfigContent(".phrase.yml", []byte(content))
+
+	exp := []string{
+		`.phrase.yml:3:13: phrase.per_page: expected integer, got string`,
+		`.phrase.yml:4:3: phrase.projct_id: unknown key "projct_id"`,
+		`.phrase.yml:9:35: phrase.defaults.locale/download.include_empty_translations: expected boolean, got string`,
+		`.phrase.yml:10:7: phrase.defaults.locale/download.colour: unknown parameter "colour" of LocaleDownloadParams`,
+		`.phrase.yml:11:5: phrase.defaults.locale/upload: unknown command "locale/upload"`,
+		`.phrase.yml:21:9: phrase.push.sources[1].branch: unknown key "branch"`,
+		`.phrase.yml:24:13: phrase.profiles.us.host: expected string, got integer`,
+	}
+	if len(problems) != len(exp) {
+		t.Errorf("expected %d problems, got %d", len(exp), len(problems))
+	}
+	for i := 0; i < len(exp) && i < len(problems); i++ {
+		if got := problems[i].String(); got != exp[i] {
+			t.Errorf("expected problem %q, got %q", exp[i], got)
+		}
+	}
+
+	if problems := LintConfigContent("a.yml", []byte("phrase:\n  host: [\n")); len(problems) != 1 || problems[0].Line != 2 {
+		t.Errorf("expected a syntax error in line 2, got %v", problems)
+	}
+	if problems := LintConfigContent("a.yml", []byte("other: {}\n")); len(problems) != 2 || problems[0].Message != "'phrase' key is missing in config" {
+		t.Errorf("expected the phrase key to be missing, got %v", problems)
+	}
+	if problems := LintConfigContent("a.yml", []byte("phraseapp:\n")); len(problems) != 0 {
+		t.Errorf("expected an empty config to be valid, got %v", problems)
+	}
+}
+
+func TestWebhookEventsConfig(t *testing.T) {
+	for _, events := range []string{"keys:create,keys:update", "[keys:create, keys:update]"} {
+		content := "phrase:\n  defaults:\n    webhook/create:\n      events: " + events + "\n"
+		if problems := LintConfigContent(".phrase.yml", []byte(content)); len(problems) != 0 {
+			t.Errorf("%s: expected no problems, got %v", events, problems)
+		}
+
+		rawCfg := map[string]*Config{}
+		if err := yaml.Unmarshal([]byte(content), rawCfg); err != nil {
+			t.Fatalf("%s: didn't expect an error, got %q", events, err)
+		}
+		cfg := rawCfg["phrase"]
+		params := new(WebhookParams)
+		if err := params.ApplyValuesFromMap(cfg.Defaults["webhook/create"]); err != nil || params.Events.String() != "keys:create,keys:update" {
+			t.Errorf("%s: expected both events, got %v (%v)", events, params.Events, err)
+		}
+		params = new(WebhookParams)
+		if err := cfg.ApplyDefaults("webhook/create", params); err != nil || params.Events.String() != "keys:create,keys:update" {
+			t.Errorf("%s: expected both events of the defaults, got %v (%v)", events, params.Events, err)
+		}
+	}
+}
+
+func TestParamsKeys(t *testing.T) {
+	// Every json key of the params is a key of ApplyValuesFromMap.
+	for command, typ := range paramsCommands {
+		var exp []string
+		for i := 0; i < typ.NumField(); i++ {
+			exp = append(exp, strings.Split(typ.Field(i).Tag.Get("json"), ",")[0])
+		}
+		sort.Strings(exp)
+		if keys := paramsKeys(typ); len(exp) > 0 && !reflect.DeepEqual(keys, exp) {
+			t.Errorf("%s: expected keys %v, got %v", command, exp, keys)
+		}
+	}
+}
+
+func TestConfigJSONSchema(t *testing.T) {
+	b, err := ConfigJSONSchema()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+
+	var schema map[string]interface{}
+	if err := json.Unmarshal(b, &schema); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	phrase := schema["properties"].(map[string]interface{})["phrase"].(map[string]interface{})
+	defaults := phrase["properties"].(map[string]interface{})["defaults"].(map[string]interface{})
+	download := defaults["properties"].(map[string]interface{})["locale/download"].(map[string]interface{})
+	fileFormat := download["properties"].(map[string]interface{})["file_format"].(map[string]interface{})
+	if fileFormat["type"] != "string" || download["additionalProperties"] != false {
+		t.Errorf("unexpected schema of locale/download %v", download)
+	}
+}
@@ -249,7 +249,10 @@ func TestConfigPath_ConfigPreference(t *testing.T) {
 }
 
 func TestConfigPath_ConfigInHomeDir(t *testing.T) {
-	cwd := os.ExpandEnv("$GOPATH/src/github.com/phrase/phraseapp-go/testdata/empty")
+	// The working directory must be outside of the testdata directory,
+	// whose config would be found by walking up.
+	cwd := emptyRepository(t)
+	defer os.RemoveAll(cwd)
 	oldDir, _ := os.Getwd()
 	err := os.Chdir(cwd)
 	if err != nil {
@@ -277,7 +280,8 @@ func TestConfigPath_NoConfigAvailable(t *testing.T) {
 	// must be obfuscated (changing the CWD and HOME env variable), so
 	// user's files do not inflict the test environment.
 
-	cwd := os.ExpandEnv("$GOPATH/src/github.com/phrase/phraseapp-go/testdata/empty")
+	cwd := emptyRepository(t)
+	defer os.RemoveAll(cwd)
 	oldDir, _ := os.Getwd()
 	err := os.Chdir(cwd)
 	if err != nil {
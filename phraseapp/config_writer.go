@@ -0,0 +1,346 @@
+package phraseapp
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// MarshalConfig returns cfg as content of a config file.
+func MarshalConfig(cfg *Config) ([]byte, error) {
+	return updateConfigContent(cfg, nil, nil)
+}
+
+// configSnapshot holds the values of a config file as read, by config key
+// and normalized by normalizeYAMLValue.
+type configSnapshot struct {
+	path   string
+	values map[string]interface{}
+}
+
+// WriteConfig writes cfg to the config file at path. An existing file is
+// updated: comments, the order of keys, unknown sections and profiles are
+// kept. Use ReadConfigFile to read the file for an update: values not
+// changed since are kept as written, e.g. "${PHRASE_TOKEN}" for the token,
+// also if they read as empty. Other
+// values are kept if they read as the ones of cfg with environment
+// variables replaced, see interpolateConfigValues. Commands aren't run.
+// Only YAML files can be written.
+//
+// Configs of ReadConfig or ReadConfigFile of another file aren't written, as
+// they hold values of other files and environment variables, e.g. tokens
+// that mustn't end up in a project's config file.
+func WriteConfig(path string, cfg *Config) error {
+	if format := configFormat(path); format != "yaml" {
+		return fmt.Errorf("%s: writing %s config files isn't supported", path, strings.ToUpper(format))
+	}
+
+	existing, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	var read map[string]interface{}
+	if cfg.read != nil && filepath.Clean(cfg.read.path) == filepath.Clean(path) {
+		read = cfg.read.values
+	} else if cfg.origins != nil {
+		return fmt.Errorf("%s: config wasn't read from this file, use ReadConfigFile to read it for an update", path)
+	}
+	content, err := updateConfigContent(cfg, existing, read)
+	if err != nil {
+		return fmt.Errorf("%s: %s", path, err)
+	}
+	return ioutil.WriteFile(path, content, 0600)
+}
+
+// ReadConfigFile reads the config file at path alone, without other files,
+// environment variables or profiles applied. Environment variables in values
+// are replaced, but secrets read from files or commands are kept as
+// written, e.g. "cmd:pass show phrase", see resolveConfigSecrets.
+func ReadConfigFile(path string) (*Config, error) {
+	values, err := readConfigValues(path)
+	if err != nil {
+		return nil, err
+	}
+	delete(values, "profiles")
+
+	location := &ConfigLocation{Path: path, Reason: "read directly"}
+	origins := map[string]*ConfigOrigin{}
+	mergeConfigValues(map[string]interface{}{}, origins, "", values, fixedOrigin(&ConfigOrigin{File: location}))
+
+	cfg, err := configFromValues(values)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+	cfg.Location = location
+	cfg.origins = origins
+
+	fileValues, err := configFileValues(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+	cfg.read = &configSnapshot{path: path, values: map[string]interface{}{}}
+	for _, kv := range fileValues {
+		if cfg.read.values[kv.Key], err = normalizeYAMLValue(kv.Value); err != nil {
+			return nil, fmt.Errorf("%s: %s", path, err)
+		}
+	}
+	return cfg, nil
+}
+
+type configKeyValue struct {
+	Key   string
+	Value interface{}
+}
+
+// configFileValues returns the values of cfg by config key, in the order
+// they are written to new files. Unset values are nil.
+func configFileValues(cfg *Config) ([]configKeyValue, error) {
+	str := func(s string) interface{} {
+		if s == "" {
+			return nil
+		}
+		return s
+	}
+	num := func(i *int) interface{} {
+		if i == nil {
+			return nil
+		}
+		return *i
+	}
+	raw := func(b []byte) (interface{}, error) {
+		var v interface{}
+		err := yaml.Unmarshal(b, &v)
+		return v, err
+	}
+
+	values := []configKeyValue{
+		{"access_token", str(cfg.Token)},
+		{"host", str(cfg.Host)},
+		{"project_id", str(cfg.DefaultProjectID)},
+		{"file_format", str(cfg.DefaultFileFormat)},
+		{"profile", str(cfg.Profile)},
+		{"debug", nil},
+		{"page", num(cfg.Page)},
+		{"per_page", num(cfg.PerPage)},
+		{"defaults", nil},
+	}
+	if cfg.Debug {
+		values[5].Value = true
+	}
+	if len(cfg.Defaults) > 0 {
+		values[8].Value = cfg.Defaults
+	}
+
+	push, err := raw(cfg.Sources)
+	if err != nil {
+		return nil, fmt.Errorf("push: %s", err)
+	}
+	pull, err := raw(cfg.Targets)
+	if err != nil {
+		return nil, fmt.Errorf("pull: %s", err)
+	}
+	return append(values, configKeyValue{"push", push}, configKeyValue{"pull", pull}), nil
+}
+
+// updateConfigContent returns the existing content updated to cfg. Nodes
+// of values equal to those read, if given, are kept as they are.
+func updateConfigContent(cfg *Config, existing []byte, read map[string]interface{}) ([]byte, error) {
+	values, err := configFileValues(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, section, err := parseConfigDocument(existing, true)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, kv := range values {
+		value, err := normalizeYAMLValue(kv.Value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", kv.Key, err)
+		}
+		if prev, found := read[kv.Key]; found && reflect.DeepEqual(prev, value) {
+			continue
+		}
+		if value == nil {
+			removeYAMLKey(section, kv.Key)
+			continue
+		}
+		if node := yamlMappingValue(section, kv.Key); node != nil {
+			err = updateYAMLNode(node, value, read[kv.Key])
+		} else {
+			err = appendYAMLKey(section, kv.Key, value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s: %s", kv.Key, err)
+		}
+	}
+
+	return encodeConfigDocument(doc)
+}
+
+// parseConfigDocument parses the content of a config file and returns the
+// document with its phrase section, or phraseapp section of older files. A
+// missing section is added if create is set, otherwise it is an error.
+func parseConfigDocument(content []byte, create bool) (doc, section *yamlv3.Node, err error) {
+	doc = new(yamlv3.Node)
+	if len(bytes.TrimSpace(content)) > 0 {
+		if err := yamlv3.Unmarshal(content, doc); err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(doc.Content) == 0 {
+		*doc = yamlv3.Node{Kind: yamlv3.DocumentNode, Content: []*yamlv3.Node{{Kind: yamlv3.MappingNode, Tag: "!!map"}}}
+	}
+	root := doc.Content[0]
+	if root.Kind != yamlv3.MappingNode {
+		return nil, nil, fmt.Errorf("expected a mapping at line %d", root.Line)
+	}
+
+	section = yamlMappingValue(root, "phrase")
+	if section == nil {
+		section = yamlMappingValue(root, "phraseapp")
+	}
+	if section == nil {
+		if !create {
+			return nil, nil, fmt.Errorf("'phrase' key is missing in config")
+		}
+		section = &yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map"}
+		root.Content = append(root.Content, &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: "phrase"}, section)
+	}
+	if section.Kind == yamlv3.ScalarNode && section.ShortTag() == "!!null" {
+		*section = yamlv3.Node{Kind: yamlv3.MappingNode, Tag: "!!map", HeadComment: section.HeadComment, LineComment: section.LineComment}
+	}
+	if section.Kind != yamlv3.MappingNode {
+		return nil, nil, fmt.Errorf("expected a mapping at line %d", section.Line)
+	}
+	return doc, section, nil
+}
+
+func encodeConfigDocument(doc *yamlv3.Node) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	enc := yamlv3.NewEncoder(buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// normalizeYAMLValue returns value as decoded from YAML, e.g. with string
+// keyed maps, for comparison with decoded nodes.
+func normalizeYAMLValue(value interface{}) (interface{}, error) {
+	var node yamlv3.Node
+	if err := node.Encode(value); err != nil {
+		return nil, err
+	}
+	var normalized interface{}
+	err := node.Decode(&normalized)
+	return normalized, err
+}
+
+// updateYAMLNode sets the value of node, keeping its comments and the parts
+// already holding the value. Parts whose value equals the one read, if
+// given, are kept as they are.
+func updateYAMLNode(node *yamlv3.Node, value, read interface{}) error {
+	if (read != nil && reflect.DeepEqual(read, value)) || yamlNodeEquals(node, value) {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if node.Kind != yamlv3.MappingNode {
+			break
+		}
+		readMap, _ := read.(map[string]interface{})
+		for i := 0; i+1 < len(node.Content); {
+			if _, found := v[node.Content[i].Value]; found {
+				i += 2
+				continue
+			}
+			node.Content = append(node.Content[:i], node.Content[i+2:]...)
+		}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			var err error
+			if existing := yamlMappingValue(node, key); existing != nil {
+				err = updateYAMLNode(existing, v[key], readMap[key])
+			} else {
+				err = appendYAMLKey(node, key, v[key])
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case []interface{}:
+		if node.Kind != yamlv3.SequenceNode || len(node.Content) != len(v) {
+			break
+		}
+		readList, _ := read.([]interface{})
+		for i, item := range v {
+			var readItem interface{}
+			if i < len(readList) {
+				readItem = readList[i]
+			}
+			if err := updateYAMLNode(node.Content[i], item, readItem); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	var replacement yamlv3.Node
+	if err := replacement.Encode(value); err != nil {
+		return err
+	}
+	replacement.HeadComment, replacement.LineComment, replacement.FootComment = node.HeadComment, node.LineComment, node.FootComment
+	*node = replacement
+	return nil
+}
+
+// yamlNodeEquals tells whether node reads as value, also with environment
+// variables replaced, see interpolateConfigString.
+func yamlNodeEquals(node *yamlv3.Node, value interface{}) bool {
+	var decoded interface{}
+	if err := node.Decode(&decoded); err == nil && reflect.DeepEqual(decoded, value) {
+		return true
+	}
+	s, ok := value.(string)
+	return ok && node.Kind == yamlv3.ScalarNode && interpolateConfigString(node.Value) == s
+}
+
+func appendYAMLKey(mapping *yamlv3.Node, key string, value interface{}) error {
+	var node yamlv3.Node
+	if err := node.Encode(value); err != nil {
+		return err
+	}
+	mapping.Content = append(mapping.Content, &yamlv3.Node{Kind: yamlv3.ScalarNode, Tag: "!!str", Value: key}, &node)
+	return nil
+}
+
+func removeYAMLKey(mapping *yamlv3.Node, key string) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+			return
+		}
+	}
+}
@@ -0,0 +1,206 @@
+package phraseapp
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWriteConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, ".phrase.yml")
+
+	ioutil.WriteFile(path, []byte(`# Phrase config of the app
+phrase:
+  # read from the environment
+  access_token: ${PHRASE_TEST_TOKEN}
+  project_id: abc # the app
+  per_page: 10
+  defaults:
+    locale/download:
+      file_format: yml
+      encoding: UTF-8
+  push:
+    sources:
+      - file: ./locales/<locale_name>.yml # all locales
+  profiles:
+    us:
+      host: https://api.us.app.phrase.com
+other_tool:
+  enabled: true
+`), 0600)
+	os.Setenv("PHRASE_TEST_TOKEN", "secret")
+	defer os.Unsetenv("PHRASE_TEST_TOKEN")
+
+	cfg, err := ReadConfigFile(path)
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if cfg.Token != "secret" || cfg.Host != "" || cfg.Origin("project_id").File.Path != path {
+		t.Errorf("expected the file alone to be read, got %+v", cfg)
+	}
+
+	cfg.DefaultProjectID = "def"
+	cfg.PerPage = nil
+	cfg.Defaults["locale/download"]["file_format"] = "json"
+	if err := WriteConfig(path, cfg); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+
+	b, _ := ioutil.ReadFile(path)
+	exp := `# Phrase config of the app
+phrase:
+  # read from the environment
+  access_token: ${PHRASE_TEST_TOKEN}
+  project_id: def # the app
+  defaults:
+    locale/download:
+      file_format: json
+      encoding: UTF-8
+  push:
+    sources:
+      - file: ./locales/<locale_name>.yml # all locales
+  profiles:
+    us:
+      host: https://api.us.app.phrase.com
+other_tool:
+  enabled: true
+`
+	if string(b) != exp {
+		t.Errorf("expected updated config\n%s\ngot\n%s", exp, b)
+	}
+
+	reread, err := ReadConfigFile(path)
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if reread.DefaultProjectID != "def" || reread.PerPage != nil || reread.Defaults["locale/download"]["file_format"] != "json" {
+		t.Errorf("expected the config to round trip, got %+v", reread)
+	}
+}
+
+func TestWriteConfigKeepsReferences(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, ".phrase.yml")
+	marker := filepath.Join(dir, "ran")
+
+	content := `phrase:
+  access_token: ${PHRASE_TEST_UNSET_TOKEN} # set in CI
+  project_id: abc
+  profiles:
+    us:
+      access_token: cmd:touch ` + marker + `
+    eu:
+      access_token: file:missing-token
+`
+	ioutil.WriteFile(path, []byte(content), 0600)
+	os.Unsetenv("PHRASE_TEST_UNSET_TOKEN")
+	os.Setenv(configCommandsEnvVar, "true")
+	defer os.Unsetenv(configCommandsEnvVar)
+
+	cfg, err := ReadConfigFile(path)
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if cfg.Token != "" {
+		t.Errorf("expected an empty token, got %q", cfg.Token)
+	}
+	if err := WriteConfig(path, cfg); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if b, _ := ioutil.ReadFile(path); string(b) != content {
+		t.Errorf("expected config to be kept\n%s\ngot\n%s", content, b)
+	}
+
+	cfg.DefaultProjectID = "def"
+	if err := WriteConfig(path, cfg); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	exp := strings.Replace(content, "project_id: abc", "project_id: def", 1)
+	if b, _ := ioutil.ReadFile(path); string(b) != exp {
+		t.Errorf("expected updated config\n%s\ngot\n%s", exp, b)
+	}
+	if _, err := os.Stat(marker); !os.IsNotExist(err) {
+		t.Errorf("expected no command to run when reading or writing")
+	}
+}
+
+func TestWriteConfigOfOtherSources(t *testing.T) {
+	repo := emptyRepository(t)
+	defer os.RemoveAll(repo)
+	path := filepath.Join(repo, ".phrase.yml")
+	content := "phrase:\n  project_id: abc\n"
+	ioutil.WriteFile(path, []byte(content), 0600)
+
+	oldDir, _ := os.Getwd()
+	os.Chdir(repo)
+	defer os.Chdir(oldDir)
+	oldHome := os.Getenv("HOME")
+	os.Setenv("HOME", repo)
+	defer os.Setenv("HOME", oldHome)
+	os.Setenv("PHRASEAPP_ACCESS_TOKEN", "SECRET_FROM_ENV")
+	defer os.Unsetenv("PHRASEAPP_ACCESS_TOKEN")
+
+	cfg, err := ReadConfig()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if cfg.Token != "SECRET_FROM_ENV" {
+		t.Fatalf("expected the token of the environment, got %q", cfg.Token)
+	}
+	if err := WriteConfig(path, cfg); err == nil || !strings.HasSuffix(err.Error(), "config wasn't read from this file, use ReadConfigFile to read it for an update") {
+		t.Errorf("expected an error for a merged config, got %v", err)
+	}
+
+	cfg, err = ReadConfigFile(path)
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	cfg.DefaultProjectID = "def"
+	if err := WriteConfig(path, cfg); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if b, _ := ioutil.ReadFile(path); string(b) != "phrase:\n  project_id: def\n" {
+		t.Errorf("expected the token not to be written, got\n%s", b)
+	}
+	if err := WriteConfig(filepath.Join(repo, "other.yml"), cfg); err == nil {
+		t.Errorf("expected an error writing the config to another file")
+	}
+}
+
+func TestMarshalConfig(t *testing.T) {
+	page := 2
+	b, err := MarshalConfig(&Config{
+		Credentials:      Credentials{Token: "abc"},
+		DefaultProjectID: "def",
+		Page:             &page,
+		Sources:          []byte("sources:\n- file: en.yml\n"),
+	})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	exp := `phrase:
+  access_token: abc
+  project_id: def
+  page: 2
+  push:
+    sources:
+      - file: en.yml
+`
+	if string(b) != exp {
+		t.Errorf("expected config\n%s\ngot\n%s", exp, b)
+	}
+	if problems := LintConfigContent("", b); len(problems) != 0 {
+		t.Errorf("expected a valid config, got %v", problems[0])
+	}
+}
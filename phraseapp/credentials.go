@@ -0,0 +1,231 @@
+package phraseapp
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/bgentry/speakeasy"
+)
+
+// Secret is a credential returned by a CredentialProvider. Either Token or
+// Username and Password are set.
+type Secret struct {
+	Token    string
+	Username string
+	Password string
+}
+
+// CredentialProvider supplies credentials for the given API host. It returns
+// nil and no error if it has no credentials for the host.
+type CredentialProvider interface {
+	Secret(host string) (*Secret, error)
+}
+
+// Prompter asks for the password and TFA token of a username based login.
+type Prompter interface {
+	Password(username string) (string, error)
+	OTP(username string) (string, error)
+}
+
+// StaticToken provides a fixed access token.
+type StaticToken string
+
+func (token StaticToken) Secret(host string) (*Secret, error) {
+	if token == "" {
+		return nil, nil
+	}
+	return &Secret{Token: string(token)}, nil
+}
+
+// EnvToken provides the access token from the named environment variable.
+type EnvToken string
+
+func (name EnvToken) Secret(host string) (*Secret, error) {
+	if token := os.Getenv(string(name)); token != "" {
+		return &Secret{Token: token}, nil
+	}
+	return nil, nil
+}
+
+// TokenFileProvider reads the access token from a file. A missing file is
+// not an error.
+type TokenFileProvider string
+
+func (path TokenFileProvider) Secret(host string) (*Secret, error) {
+	content, err := ioutil.ReadFile(string(path))
+	switch {
+	case os.IsNotExist(err):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	if token := strings.TrimSpace(string(content)); token != "" {
+		return &Secret{Token: token}, nil
+	}
+	return nil, nil
+}
+
+// CommandProvider runs an external credential helper, similar to git's
+// credential helpers. The command is called with the argument "get" and
+// receives "protocol=<scheme>" and "host=<host>" lines on stdin. It answers
+// with "key=value" lines; the keys token, username and password are used. A
+// password without a username is treated as access token.
+//
+// The result is cached for the lifetime of the provider.
+type CommandProvider struct {
+	Command string
+	Args    []string
+
+	mu     sync.Mutex
+	cached map[string]*Secret
+}
+
+// NewCommandProvider returns a provider running the given helper command.
+func NewCommandProvider(command string, args ...string) *CommandProvider {
+	return &CommandProvider{Command: command, Args: args}
+}
+
+func (p *CommandProvider) Secret(host string) (*Secret, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if secret, found := p.cached[host]; found {
+		return secret, nil
+	}
+
+	scheme, hostname := splitHost(host)
+	stdin := fmt.Sprintf("protocol=%s\nhost=%s\n\n", scheme, hostname)
+
+	stdout := new(bytes.Buffer)
+	stderr := new(bytes.Buffer)
+	cmd := exec.Command(p.Command, append(append([]string{}, p.Args...), "get")...)
+	cmd.Stdin = strings.NewReader(stdin)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("credential helper %q failed: %s %s", p.Command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	values := map[string]string{}
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		kv := strings.SplitN(scanner.Text(), "=", 2)
+		if len(kv) == 2 {
+			values[kv[0]] = kv[1]
+		}
+	}
+
+	var secret *Secret
+	switch {
+	case values["token"] != "":
+		secret = &Secret{Token: values["token"]}
+	case values["username"] != "" && values["password"] != "":
+		secret = &Secret{Username: values["username"], Password: values["password"]}
+	case values["password"] != "":
+		secret = &Secret{Token: values["password"]}
+	}
+
+	if p.cached == nil {
+		p.cached = map[string]*Secret{}
+	}
+	p.cached[host] = secret
+	return secret, nil
+}
+
+// NetrcProvider looks up the access token in a netrc style file. The
+// password of the entry matching the host (or the default entry) is used as
+// access token. If Path is empty, ~/.netrc is used.
+type NetrcProvider struct {
+	Path string
+}
+
+func (p NetrcProvider) Secret(host string) (*Secret, error) {
+	path := p.Path
+	if path == "" {
+		path = filepath.Join(defaultConfigDir(), ".netrc")
+	}
+
+	content, err := ioutil.ReadFile(path)
+	switch {
+	case os.IsNotExist(err):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	_, hostname := splitHost(host)
+	var machine, token, fallback string
+	fields := strings.Fields(string(content))
+	for i := 0; i < len(fields); i++ {
+		var value string
+		if i+1 < len(fields) {
+			value = fields[i+1]
+		}
+
+		switch fields[i] {
+		case "machine":
+			machine = value
+			i++
+		case "default":
+			machine = ""
+		case "login", "account":
+			i++
+		case "password":
+			i++
+			if machine == hostname && token == "" {
+				token = value
+			} else if machine == "" && fallback == "" {
+				fallback = value
+			}
+		}
+	}
+
+	if token == "" {
+		token = fallback
+	}
+	if token == "" {
+		return nil, nil
+	}
+	return &Secret{Token: token}, nil
+}
+
+// TerminalPrompter asks for password and TFA token on the terminal. It is
+// used when no Prompter is set on the Client.
+type TerminalPrompter struct{}
+
+func (TerminalPrompter) Password(username string) (string, error) {
+	return speakeasy.Ask("Password: ")
+}
+
+func (TerminalPrompter) OTP(username string) (string, error) {
+	return speakeasy.Ask("TFA-Token: ")
+}
+
+// NoPrompter fails instead of asking for a password or TFA token. Use it in
+// non-interactive processes.
+type NoPrompter struct{}
+
+func (NoPrompter) Password(username string) (string, error) {
+	return "", fmt.Errorf("password for %q required but prompting is disabled", username)
+}
+
+func (NoPrompter) OTP(username string) (string, error) {
+	return "", fmt.Errorf("TFA token for %q required but prompting is disabled", username)
+}
+
+func splitHost(host string) (string, string) {
+	u, err := url.Parse(host)
+	if err != nil || u.Host == "" {
+		return "https", host
+	}
+	return u.Scheme, u.Host
+}
@@ -0,0 +1,77 @@
+package phraseapp
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+)
+
+type testPrompter struct{ password, otp string }
+
+func (p testPrompter) Password(username string) (string, error) { return p.password, nil }
+func (p testPrompter) OTP(username string) (string, error)      { return p.otp, nil }
+
+func TestCommandProvider(t *testing.T) {
+	p := NewCommandProvider("sh", "-c", `read proto; read host; echo "token=$host"`, "helper")
+	secret, err := p.Secret("https://api.example.com")
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if secret == nil || secret.Token != "host=api.example.com" {
+		t.Errorf("expected token from helper, got %+v", secret)
+	}
+}
+
+func TestNetrcProvider(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "")
+	path := filepath.Join(dir, "netrc")
+	ioutil.WriteFile(path, []byte("machine other.com login x password nope\nmachine api.phrase.com\n  login me\n  password secret\ndefault password fallback\n"), 0600)
+
+	secret, err := NetrcProvider{Path: path}.Secret("https://api.phrase.com")
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if secret == nil || secret.Token != "secret" {
+		t.Errorf("expected token %q, got %+v", "secret", secret)
+	}
+
+	secret, _ = NetrcProvider{Path: path}.Secret("https://api.us.app.phrase.com")
+	if secret == nil || secret.Token != "fallback" {
+		t.Errorf("expected token %q, got %+v", "fallback", secret)
+	}
+}
+
+func TestAuthenticateWithProviders(t *testing.T) {
+	var auth, otp string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth, otp = r.Header.Get("Authorization"), r.Header.Get("X-PhraseApp-OTP")
+		io.WriteString(w, "{}")
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Credentials{Host: server.URL}, false)
+	client.CredentialProviders = []CredentialProvider{EnvToken("PHRASEAPP_TEST_UNSET_TOKEN"), StaticToken("abc")}
+	if _, err := client.ShowUser(); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if auth != "token abc" {
+		t.Errorf("expected token from provider, got %q", auth)
+	}
+
+	client, _ = NewClient(Credentials{Host: server.URL, Username: "me", TFA: true}, false)
+	client.Prompter = testPrompter{password: "pwd", otp: "123456"}
+	if _, err := client.ShowUser(); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if auth != "Basic bWU6cHdk" || otp != "123456" {
+		t.Errorf("expected basic auth with otp, got %q and %q", auth, otp)
+	}
+
+	client.Prompter = NoPrompter{}
+	if _, err := client.ShowUser(); err == nil {
+		t.Errorf("expected an error, got none")
+	}
+}
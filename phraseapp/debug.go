@@ -0,0 +1,109 @@
+package phraseapp
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxDebugBodySize is the number of body bytes printed in debug mode.
+const maxDebugBodySize = 4096
+
+// Redacted replaces credentials in debug output and recorded cassettes.
+const Redacted = "[REDACTED]"
+
+// redactHeader returns a copy of the header with credentials replaced.
+func redactHeader(header http.Header) http.Header {
+	h := make(http.Header, len(header))
+	for k, v := range header {
+		h[k] = append([]string{}, v...)
+	}
+
+	if auth := h.Get("Authorization"); auth != "" {
+		if i := strings.IndexByte(auth, ' '); i > 0 {
+			h.Set("Authorization", auth[:i+1]+Redacted)
+		} else {
+			h.Set("Authorization", Redacted)
+		}
+	}
+	if h.Get("X-PhraseApp-OTP") != "" {
+		h.Set("X-PhraseApp-OTP", Redacted)
+	}
+	return h
+}
+
+// redactURL removes user info from a URL.
+func redactURL(u *url.URL) string {
+	if u.User == nil {
+		return u.String()
+	}
+	c := *u
+	c.User = url.User(Redacted)
+	return c.String()
+}
+
+// debugBody renders a request body for debug output. Multipart bodies are
+// printed field by field with file contents omitted, other bodies are
+// truncated to maxDebugBodySize.
+func debugBody(body []byte, contentType string) string {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err == nil && strings.HasPrefix(mediaType, "multipart/") {
+		if s, err := debugMultipart(body, params["boundary"]); err == nil {
+			return s
+		}
+	}
+	return truncateDebug(body)
+}
+
+func debugMultipart(body []byte, boundary string) (string, error) {
+	buf := new(bytes.Buffer)
+	reader := multipart.NewReader(bytes.NewReader(body), boundary)
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return buf.String(), nil
+		}
+		if err != nil {
+			return "", err
+		}
+
+		content, err := ioutil.ReadAll(part)
+		if err != nil {
+			return "", err
+		}
+
+		if part.FileName() != "" || !utf8.Valid(content) {
+			fmt.Fprintf(buf, "\n  %s: <file %q, %d bytes>", part.FormName(), part.FileName(), len(content))
+		} else {
+			fmt.Fprintf(buf, "\n  %s: %s", part.FormName(), truncateDebug(content))
+		}
+	}
+}
+
+// tokenPattern matches access tokens in JSON bodies, e.g. the token of a
+// created authorization.
+var tokenPattern = regexp.MustCompile(`("(?:token|access_token)"\s*:\s*)"[^"]*"`)
+
+// RedactTokens returns the body with access tokens in JSON replaced by
+// Redacted.
+func RedactTokens(body []byte) []byte {
+	return tokenPattern.ReplaceAll(body, []byte(`$1"`+Redacted+`"`))
+}
+
+// truncateDebug renders a body for debug output with access tokens redacted,
+// truncated to maxDebugBodySize.
+func truncateDebug(body []byte) string {
+	body = RedactTokens(body)
+	if len(body) <= maxDebugBodySize {
+		return string(body)
+	}
+	return fmt.Sprintf("%s... (%d more bytes)", body[:maxDebugBodySize], len(body)-maxDebugBodySize)
+}
@@ -0,0 +1,61 @@
+package phraseapp
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestRedactHeader(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "token secret")
+	header.Set("X-PhraseApp-OTP", "123456")
+	header.Set("User-Agent", "test")
+
+	h := redactHeader(header)
+	if got := h.Get("Authorization"); got != "token [REDACTED]" {
+		t.Errorf("expected redacted token, got %q", got)
+	}
+	if got := h.Get("X-PhraseApp-OTP"); got != "[REDACTED]" {
+		t.Errorf("expected redacted otp, got %q", got)
+	}
+	if got := h.Get("User-Agent"); got != "test" {
+		t.Errorf("expected user agent to be kept, got %q", got)
+	}
+	if header.Get("Authorization") != "token secret" {
+		t.Errorf("expected original header to be unchanged")
+	}
+
+	req, _ := http.NewRequest("GET", "https://api.phrase.com", nil)
+	req.SetBasicAuth("me", "pwd")
+	if got := redactHeader(req.Header).Get("Authorization"); got != "Basic [REDACTED]" {
+		t.Errorf("expected redacted basic auth, got %q", got)
+	}
+}
+
+func TestDebugBody(t *testing.T) {
+	buf := new(bytes.Buffer)
+	writer := multipart.NewWriter(buf)
+	writer.WriteField("file_format", "strings")
+	part, _ := writer.CreateFormFile("file", "Localizable.strings")
+	part.Write([]byte("\"a\" = \"b\";"))
+	writer.Close()
+
+	got := debugBody(buf.Bytes(), writer.FormDataContentType())
+	exp := "\n  file_format: strings\n  file: <file \"Localizable.strings\", 10 bytes>"
+	if got != exp {
+		t.Errorf("expected %q, got %q", exp, got)
+	}
+
+	long := strings.Repeat("a", maxDebugBodySize+10)
+	if got := debugBody([]byte(long), "application/json"); !strings.HasSuffix(got, "... (10 more bytes)") {
+		t.Errorf("expected body to be truncated, got suffix %q", got[len(got)-30:])
+	}
+
+	token := `{"id":"a1","token": "0123456789abcdef","note":"ci"}`
+	if got := debugBody([]byte(token), "application/json"); got != `{"id":"a1","token": "[REDACTED]","note":"ci"}` {
+		t.Errorf("expected token to be redacted, got %q", got)
+	}
+}
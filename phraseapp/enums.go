@@ -0,0 +1,242 @@
+package phraseapp
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// FileFormat is the API name of a file format, e.g. "yml". The formats of
+// a host are listed by FormatsList, see FormatRegistry.
+type FileFormat string
+
+// File formats of the API spec.
+const (
+	FormatYML               FileFormat = "yml"
+	FormatYMLSymfony        FileFormat = "yml_symfony"
+	FormatYMLSymfony2       FileFormat = "yml_symfony2"
+	FormatGettext           FileFormat = "gettext"
+	FormatGettextTemplate   FileFormat = "gettext_template"
+	FormatAndroidXML        FileFormat = "xml"
+	FormatIOSStrings        FileFormat = "strings"
+	FormatIOSStringsdict    FileFormat = "stringsdict"
+	FormatProperties        FileFormat = "properties"
+	FormatPropertiesXML     FileFormat = "properties_xml"
+	FormatMozillaProperties FileFormat = "mozilla_properties"
+	FormatXLIFF             FileFormat = "xlf"
+	FormatSimpleJSON        FileFormat = "simple_json"
+	FormatNestedJSON        FileFormat = "nested_json"
+	FormatReactSimpleJSON   FileFormat = "react_simple_json"
+	FormatReactNestedJSON   FileFormat = "react_nested_json"
+	FormatNodeJSON          FileFormat = "node_json"
+	FormatI18next           FileFormat = "i18next"
+	FormatGoI18n            FileFormat = "go_i18n"
+	FormatAngularTranslate  FileFormat = "angular_translate"
+	FormatEmberJS           FileFormat = "ember_js"
+	FormatCSV               FileFormat = "csv"
+	FormatZendeskCSV        FileFormat = "zendesk_csv"
+	FormatXLSX              FileFormat = "xlsx"
+	FormatRESX              FileFormat = "resx"
+	FormatRESXWindowsPhone  FileFormat = "resx_windowsphone"
+	FormatWindows8Resource  FileFormat = "windows8_resource"
+	FormatINI               FileFormat = "ini"
+	FormatPlist             FileFormat = "plist"
+	FormatTMX               FileFormat = "tmx"
+	FormatQtTranslationTS   FileFormat = "ts"
+	FormatQtPhraseBook      FileFormat = "qph"
+	FormatPHPArray          FileFormat = "php_array"
+	FormatLaravel           FileFormat = "laravel"
+)
+
+var fileFormats = []FileFormat{
+	FormatYML, FormatYMLSymfony, FormatYMLSymfony2, FormatGettext, FormatGettextTemplate,
+	FormatAndroidXML, FormatIOSStrings, FormatIOSStringsdict, FormatProperties, FormatPropertiesXML,
+	FormatMozillaProperties, FormatXLIFF, FormatSimpleJSON, FormatNestedJSON, FormatReactSimpleJSON,
+	FormatReactNestedJSON, FormatNodeJSON, FormatI18next, FormatGoI18n, FormatAngularTranslate,
+	FormatEmberJS, FormatCSV, FormatZendeskCSV, FormatXLSX, FormatRESX, FormatRESXWindowsPhone,
+	FormatWindows8Resource, FormatINI, FormatPlist, FormatTMX, FormatQtTranslationTS,
+	FormatQtPhraseBook, FormatPHPArray, FormatLaravel,
+}
+
+// IsValid tells whether f is a format of the API spec. Hosts may support
+// others, use FormatRegistry to ask the host.
+func (f FileFormat) IsValid() bool {
+	for _, format := range fileFormats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// UploadState is the state of an Upload.
+type UploadState string
+
+// Upload states.
+const (
+	UploadStateInitialized UploadState = "initialized"
+	UploadStateProcessing  UploadState = "processing"
+	UploadStateSuccess     UploadState = "success"
+	UploadStateError       UploadState = "error"
+)
+
+// IsValid tells whether s is a known upload state.
+func (s UploadState) IsValid() bool {
+	switch s {
+	case UploadStateInitialized, UploadStateProcessing, UploadStateSuccess, UploadStateError:
+		return true
+	}
+	return false
+}
+
+// JobState is the state of a Job.
+type JobState string
+
+// Job states.
+const (
+	JobStateDraft      JobState = "draft"
+	JobStateInProgress JobState = "in_progress"
+	JobStateCompleted  JobState = "completed"
+)
+
+// IsValid tells whether s is a known job state.
+func (s JobState) IsValid() bool {
+	switch s {
+	case JobStateDraft, JobStateInProgress, JobStateCompleted:
+		return true
+	}
+	return false
+}
+
+// BranchState is the state of a Branch.
+type BranchState string
+
+// Branch states.
+const (
+	BranchStateInitializing BranchState = "initializing"
+	BranchStateSuccess      BranchState = "success"
+	BranchStateMerged       BranchState = "merged"
+)
+
+// IsValid tells whether s is a known branch state.
+func (s BranchState) IsValid() bool {
+	switch s {
+	case BranchStateInitializing, BranchStateSuccess, BranchStateMerged:
+		return true
+	}
+	return false
+}
+
+// BranchMergeStrategy decides which translations win conflicts when
+// merging a branch, see BranchMergeParams.
+type BranchMergeStrategy string
+
+// Branch merge strategies.
+const (
+	BranchMergeUseMain   BranchMergeStrategy = "use_main"
+	BranchMergeUseBranch BranchMergeStrategy = "use_branch"
+)
+
+// IsValid tells whether s is a known merge strategy.
+func (s BranchMergeStrategy) IsValid() bool {
+	switch s {
+	case BranchMergeUseMain, BranchMergeUseBranch:
+		return true
+	}
+	return false
+}
+
+// WebhookEvent is an event a Webhook is called for.
+type WebhookEvent string
+
+// Webhook events.
+const (
+	WebhookCommentsCreate            WebhookEvent = "comments:create"
+	WebhookJobsCreate                WebhookEvent = "jobs:create"
+	WebhookJobsStart                 WebhookEvent = "jobs:start"
+	WebhookJobsComplete              WebhookEvent = "jobs:complete"
+	WebhookJobsLocaleComplete        WebhookEvent = "jobs:locale:complete"
+	WebhookKeysCreate                WebhookEvent = "keys:create"
+	WebhookKeysUpdate                WebhookEvent = "keys:update"
+	WebhookKeysDelete                WebhookEvent = "keys:delete"
+	WebhookKeysBatchDelete           WebhookEvent = "keys:batch_delete"
+	WebhookLocalesCreate             WebhookEvent = "locales:create"
+	WebhookLocalesUpdate             WebhookEvent = "locales:update"
+	WebhookLocalesDelete             WebhookEvent = "locales:delete"
+	WebhookLocalesDownload           WebhookEvent = "locales:download"
+	WebhookTranslationsCreate        WebhookEvent = "translations:create"
+	WebhookTranslationsUpdate        WebhookEvent = "translations:update"
+	WebhookTranslationsDeliver       WebhookEvent = "translations:deliver"
+	WebhookTranslationsVerify        WebhookEvent = "translations:verify"
+	WebhookTranslationsUnverify      WebhookEvent = "translations:unverify"
+	WebhookTranslationsBatchVerify   WebhookEvent = "translations:batch_verify"
+	WebhookTranslationsBatchUnverify WebhookEvent = "translations:batch_unverify"
+	WebhookUploadsCreate             WebhookEvent = "uploads:create"
+	WebhookUploadsProcessing         WebhookEvent = "uploads:processing"
+	WebhookUploadsComplete           WebhookEvent = "uploads:complete"
+	WebhookUploadsFailed             WebhookEvent = "uploads:failed"
+)
+
+var webhookEvents = []WebhookEvent{
+	WebhookCommentsCreate, WebhookJobsCreate, WebhookJobsStart, WebhookJobsComplete,
+	WebhookJobsLocaleComplete, WebhookKeysCreate, WebhookKeysUpdate, WebhookKeysDelete,
+	WebhookKeysBatchDelete, WebhookLocalesCreate, WebhookLocalesUpdate, WebhookLocalesDelete,
+	WebhookLocalesDownload, WebhookTranslationsCreate, WebhookTranslationsUpdate,
+	WebhookTranslationsDeliver, WebhookTranslationsVerify, WebhookTranslationsUnverify,
+	WebhookTranslationsBatchVerify, WebhookTranslationsBatchUnverify, WebhookUploadsCreate,
+	WebhookUploadsProcessing, WebhookUploadsComplete, WebhookUploadsFailed,
+}
+
+// IsValid tells whether e is a known webhook event.
+func (e WebhookEvent) IsValid() bool {
+	for _, event := range webhookEvents {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookEvents are the events of WebhookParams. The API takes them joined
+// with commas.
+type WebhookEvents []WebhookEvent
+
+// ParseWebhookEvents returns the events of a comma separated list, e.g.
+// "keys:create,keys:update".
+func ParseWebhookEvents(s string) WebhookEvents {
+	var events WebhookEvents
+	for _, name := range strings.Split(s, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			events = append(events, WebhookEvent(name))
+		}
+	}
+	return events
+}
+
+// String returns the events joined with commas.
+func (events WebhookEvents) String() string {
+	names := make([]string, 0, len(events))
+	for _, e := range events {
+		names = append(names, string(e))
+	}
+	return strings.Join(names, ",")
+}
+
+// MarshalJSON encodes the events joined with commas, as the API takes them.
+func (events WebhookEvents) MarshalJSON() ([]byte, error) {
+	return json.Marshal(events.String())
+}
+
+// UnmarshalJSON decodes events joined with commas or a list of them.
+func (events *WebhookEvents) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		*events = ParseWebhookEvents(s)
+		return nil
+	}
+	var list []WebhookEvent
+	if err := json.Unmarshal(b, &list); err != nil {
+		return err
+	}
+	*events = list
+	return nil
+}
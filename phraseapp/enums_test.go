@@ -0,0 +1,82 @@
+package phraseapp
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEnumsIsValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid bool
+	}{
+		{"yml", FileFormat("yml").IsValid()},
+		{"nested_json", FormatNestedJSON.IsValid()},
+		{"success", UploadStateSuccess.IsValid()},
+		{"in_progress", JobState("in_progress").IsValid()},
+		{"merged", BranchStateMerged.IsValid()},
+		{"use_branch", BranchMergeStrategy("use_branch").IsValid()},
+		{"keys:create", WebhookEvent("keys:create").IsValid()},
+	}
+	for _, tt := range tests {
+		if !tt.valid {
+			t.Errorf("expected %q to be valid", tt.name)
+		}
+	}
+
+	for name, valid := range map[string]bool{
+		"YAML":       FileFormat("YAML").IsValid(),
+		"done":       UploadState("done").IsValid(),
+		"":           JobState("").IsValid(),
+		"Merged":     BranchState("Merged").IsValid(),
+		"use_theirs": BranchMergeStrategy("use_theirs").IsValid(),
+		"keys":       WebhookEvent("keys").IsValid(),
+	} {
+		if valid {
+			t.Errorf("expected %q to be invalid", name)
+		}
+	}
+}
+
+func TestEnumFields(t *testing.T) {
+	var upload Upload
+	if err := json.Unmarshal([]byte(`{"state":"processing"}`), &upload); err != nil || upload.State != UploadStateProcessing {
+		t.Errorf("expected upload state %q, got %q (%v)", UploadStateProcessing, upload.State, err)
+	}
+	var webhook Webhook
+	if err := json.Unmarshal([]byte(`{"events":["keys:create","uploads:failed"]}`), &webhook); err != nil || len(webhook.Events) != 2 || webhook.Events[1] != WebhookUploadsFailed {
+		t.Errorf("expected webhook events, got %v (%v)", webhook.Events, err)
+	}
+
+	params := new(BranchMergeParams)
+	if err := params.ApplyValuesFromMap(map[string]interface{}{"strategy": "use_main"}); err != nil || *params.Strategy != BranchMergeUseMain {
+		t.Errorf("expected strategy %q, got %v (%v)", BranchMergeUseMain, params.Strategy, err)
+	}
+	if q := params.QueryParams(); q["strategy"] != "use_main" {
+		t.Errorf("expected strategy query param, got %v", q)
+	}
+}
+
+func TestWebhookEvents(t *testing.T) {
+	params := &WebhookParams{Events: WebhookEvents{WebhookKeysCreate, WebhookUploadsFailed}}
+	b, err := json.Marshal(params)
+	if err != nil || string(b) != `{"events":"keys:create,uploads:failed"}` {
+		t.Errorf("expected events joined with commas, got %s (%v)", b, err)
+	}
+	if q := params.QueryParams(); q["events"] != "keys:create,uploads:failed" {
+		t.Errorf("expected events query param, got %v", q)
+	}
+
+	var decoded WebhookParams
+	if err := json.Unmarshal(b, &decoded); err != nil || decoded.Events.String() != "keys:create,uploads:failed" {
+		t.Errorf("expected events to round trip, got %v (%v)", decoded.Events, err)
+	}
+	if err := json.Unmarshal([]byte(`{"events":["jobs:start"]}`), &decoded); err != nil || len(decoded.Events) != 1 || decoded.Events[0] != WebhookJobsStart {
+		t.Errorf("expected a list of events, got %v (%v)", decoded.Events, err)
+	}
+
+	defaults := &WebhookParams{}
+	if err := defaults.ApplyValuesFromMap(map[string]interface{}{"events": "keys:create, keys:update"}); err != nil || len(defaults.Events) != 2 || defaults.Events[1] != WebhookKeysUpdate {
+		t.Errorf("expected events of the default, got %v (%v)", defaults.Events, err)
+	}
+}
@@ -1,20 +1,19 @@
 package phraseapp
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"reflect"
 	"strconv"
 	"strings"
 	"time"
 )
 
 func IsErrNotFound(err error) bool {
-	if err == nil {
-		return false
-	}
-	_, ok := err.(ErrNotFound)
-	return ok
+	return errors.Is(err, ErrNotFound{})
 }
 
 // ErrNotFound represents an error for requests of non existing resources
@@ -26,6 +25,103 @@ func (e ErrNotFound) Error() string {
 	return e.Message
 }
 
+// Is reports whether target is an ErrNotFound, so that
+// errors.Is(err, ErrNotFound{}) matches any not found error.
+func (e ErrNotFound) Is(target error) bool {
+	_, ok := target.(ErrNotFound)
+	return ok
+}
+
+// maxErrorBodySize is the number of response body bytes kept in errors.
+const maxErrorBodySize = 4096
+
+// ResponseInfo describes the failed request and its response.
+type ResponseInfo struct {
+	StatusCode int
+	Method     string
+	URL        string
+	Body       string // the first 4KB of the response body
+	RequestID  string // the X-Request-Id response header
+}
+
+func newResponseInfo(resp *http.Response) ResponseInfo {
+	info := ResponseInfo{StatusCode: resp.StatusCode, RequestID: resp.Header.Get("X-Request-Id")}
+	if resp.Request != nil {
+		info.Method = resp.Request.Method
+		info.URL = redactURL(resp.Request.URL)
+	}
+	if resp.Body != nil {
+		b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+		info.Body = string(b)
+	}
+	return info
+}
+
+// ErrUnauthorized is returned for 401 responses, i.e. invalid credentials.
+// Match it with errors.Is(err, ErrUnauthorized{}) or errors.As.
+type ErrUnauthorized struct {
+	ResponseInfo
+}
+
+func (e ErrUnauthorized) Error() string {
+	return fmt.Sprintf("%d - %s\nThe credentials you provided are invalid.%s", e.StatusCode, http.StatusText(e.StatusCode), further())
+}
+
+func (e ErrUnauthorized) Is(target error) bool {
+	_, ok := target.(ErrUnauthorized)
+	return ok
+}
+
+// ErrForbidden is returned for 403 responses, i.e. credentials lacking the
+// scope or permissions for the request.
+type ErrForbidden struct {
+	ResponseInfo
+}
+
+func (e ErrForbidden) Error() string {
+	return fmt.Sprintf("%d - %s\nYou are not authorized to perform the requested action on the requested resource. Check if your provided access_token has the correct scope.%s", e.StatusCode, http.StatusText(e.StatusCode), further())
+}
+
+func (e ErrForbidden) Is(target error) bool {
+	_, ok := target.(ErrForbidden)
+	return ok
+}
+
+// ErrServer is returned for 5xx responses.
+type ErrServer struct {
+	ResponseInfo
+	ExpectedStatus int
+}
+
+func (e ErrServer) Error() string {
+	return unexpectedStatusMessage(e.StatusCode, e.ExpectedStatus)
+}
+
+func (e ErrServer) Is(target error) bool {
+	_, ok := target.(ErrServer)
+	return ok
+}
+
+// ErrUnexpectedStatus is returned for responses with a status code the
+// client has no other error for.
+type ErrUnexpectedStatus struct {
+	ResponseInfo
+	ExpectedStatus int
+}
+
+func (e ErrUnexpectedStatus) Error() string {
+	return unexpectedStatusMessage(e.StatusCode, e.ExpectedStatus)
+}
+
+func (e ErrUnexpectedStatus) Is(target error) bool {
+	_, ok := target.(ErrUnexpectedStatus)
+	return ok
+}
+
+func unexpectedStatusMessage(status, expectedStatus int) string {
+	return fmt.Sprintf("Unexpected HTTP Status Code (%d %s) received; expected %d %s.%s", status, http.StatusText(status), expectedStatus, http.StatusText(expectedStatus), further())
+}
+
 type ErrorResponse struct {
 	Message string
 }
@@ -39,6 +135,74 @@ type ValidationErrorResponse struct {
 	ErrorResponse
 
 	Errors []ValidationErrorMessage
+
+	// Params are the params of the failed call, e.g. a *TranslationKeyParams.
+	Params interface{} `json:"-"`
+}
+
+// ForField returns the errors of a field of Params by its Go name, e.g.
+// "MaxCharactersAllowed".
+func (err *ValidationErrorResponse) ForField(name string) []ValidationErrorMessage {
+	var msgs []ValidationErrorMessage
+	for _, msg := range err.Errors {
+		if msg.GoField != "" && msg.GoField == name {
+			msgs = append(msgs, msg)
+		}
+	}
+	return msgs
+}
+
+// ForJSONField returns the errors of a field by its API name, e.g.
+// "max_characters_allowed".
+func (err *ValidationErrorResponse) ForJSONField(name string) []ValidationErrorMessage {
+	var msgs []ValidationErrorMessage
+	for _, msg := range err.Errors {
+		if msg.Field == name {
+			msgs = append(msgs, msg)
+		}
+	}
+	return msgs
+}
+
+// attachParams records the params of a call in its validation error and
+// maps the error fields to the params' Go fields.
+func attachParams(err error, params interface{}) error {
+	verr, ok := err.(*ValidationErrorResponse)
+	if !ok {
+		return err
+	}
+
+	verr.Params = params
+	fields := paramsFields(params)
+	for i, msg := range verr.Errors {
+		if name, ok := fields[msg.Field]; ok {
+			verr.Errors[i].GoField = name
+		} else if name, ok := fields[msg.Field+"_id"]; ok {
+			verr.Errors[i].GoField = name
+		}
+	}
+	return verr
+}
+
+// paramsFields maps the JSON names of a params struct's fields to their Go
+// names.
+func paramsFields(params interface{}) map[string]string {
+	t := reflect.TypeOf(params)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	fields := make(map[string]string, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if name := strings.Split(f.Tag.Get("json"), ",")[0]; name != "" && name != "-" {
+			fields[name] = f.Name
+		}
+	}
+	return fields
 }
 
 func (err *ValidationErrorResponse) Error() string {
@@ -54,6 +218,9 @@ type ValidationErrorMessage struct {
 	Resource string
 	Field    string
 	Message  string
+
+	// GoField is the name of the Params field Field refers to, if any.
+	GoField string `json:"-"`
 }
 
 func (msg *ValidationErrorMessage) String() string {
@@ -100,6 +267,13 @@ func NewRateLimitError(resp *http.Response) (*RateLimitingError, error) {
 	return re, nil
 }
 
+// Is reports whether target is a *RateLimitingError, so that
+// errors.Is(err, &RateLimitingError{}) matches any rate limiting error.
+func (rle *RateLimitingError) Is(target error) bool {
+	_, ok := target.(*RateLimitingError)
+	return ok
+}
+
 func (rle *RateLimitingError) Error() string {
 	if rle.TooManyRequests {
 		return fmt.Sprintf("Rate limit exceeded: too many parallel requests")
@@ -0,0 +1,106 @@
+package phraseapp
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestTypedErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		status, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/v2/projects/"))
+		w.Header().Set("X-Request-Id", "req-"+strconv.Itoa(status))
+		w.Header().Set("X-Rate-Limit-Limit", "1000")
+		w.Header().Set("X-Rate-Limit-Remaining", "0")
+		w.Header().Set("X-Rate-Limit-Reset", "0")
+		w.WriteHeader(status)
+		io.WriteString(w, `{"message":"failed"}`)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Credentials{Host: server.URL, Token: "secret"}, false)
+
+	tests := []struct {
+		status int
+		target error
+	}{
+		{401, ErrUnauthorized{}},
+		{403, ErrForbidden{}},
+		{404, ErrNotFound{}},
+		{429, &RateLimitingError{}},
+		{500, ErrServer{}},
+		{503, ErrServer{}},
+		{418, ErrUnexpectedStatus{}},
+	}
+	for _, tt := range tests {
+		_, err := client.ProjectShow(strconv.Itoa(tt.status))
+		if !errors.Is(err, tt.target) {
+			t.Errorf("%d: expected errors.Is to match %T, got %T", tt.status, tt.target, err)
+		}
+		if errors.Is(fmt.Errorf("wrapped: %w", err), ErrUnexpectedStatus{}) != (tt.status == 418) {
+			t.Errorf("%d: expected errors.Is to only match its own type", tt.status)
+		}
+	}
+
+	_, err := client.ProjectShow("401")
+	var unauthorized ErrUnauthorized
+	if !errors.As(fmt.Errorf("wrapped: %w", err), &unauthorized) {
+		t.Fatalf("expected errors.As to find ErrUnauthorized, got %T", err)
+	}
+	info := unauthorized.ResponseInfo
+	if info.StatusCode != 401 || info.Method != "GET" || info.URL != server.URL+"/v2/projects/401" || info.RequestID != "req-401" || info.Body != `{"message":"failed"}` {
+		t.Errorf("unexpected response info %+v", info)
+	}
+	if !strings.Contains(err.Error(), "The credentials you provided are invalid.") {
+		t.Errorf("expected the message to be unchanged, got %q", err)
+	}
+
+	_, err = client.ProjectShow("502")
+	var serverErr ErrServer
+	if !errors.As(err, &serverErr) || serverErr.StatusCode != 502 || serverErr.ExpectedStatus != 200 {
+		t.Errorf("unexpected server error %+v", err)
+	}
+	if !IsErrNotFound(fmt.Errorf("wrapped: %w", ErrNotFound{Message: "gone"})) {
+		t.Errorf("expected IsErrNotFound to match wrapped errors")
+	}
+}
+
+func TestValidationErrorFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		io.WriteString(w, `{"message":"Validation failed","errors":[
+			{"resource":"TranslationKey","field":"max_characters_allowed","message":"must be positive"},
+			{"resource":"Translation","field":"locale","message":"is invalid"},
+			{"resource":"TranslationKey","field":"base","message":"is broken"}
+		]}`)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Credentials{Host: server.URL, Token: "secret"}, false)
+
+	params := &TranslationKeyParams{}
+	_, err := client.KeyCreate("p1", params)
+	var verr *ValidationErrorResponse
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected a validation error, got %T", err)
+	}
+	if verr.Params != params {
+		t.Errorf("expected the params to be attached, got %T", verr.Params)
+	}
+	if msgs := verr.ForField("MaxCharactersAllowed"); len(msgs) != 1 || msgs[0].Message != "must be positive" {
+		t.Errorf("expected an error for MaxCharactersAllowed, got %v", msgs)
+	}
+	if msgs := verr.ForJSONField("base"); len(msgs) != 1 || msgs[0].GoField != "" {
+		t.Errorf("expected an unmapped error for base, got %v", msgs)
+	}
+
+	_, err = client.TranslationCreate("p1", &TranslationParams{})
+	if verr, ok := err.(*ValidationErrorResponse); !ok || len(verr.ForField("LocaleID")) != 1 {
+		t.Errorf("expected locale to map to LocaleID, got %v", err)
+	}
+}
@@ -0,0 +1,374 @@
+package phraseapp
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// FormatDetection is a file format chosen by DetectFormat.
+type FormatDetection struct {
+	Format FileFormat
+	// Reason explains why the format was chosen, e.g. "keys are nested
+	// below the locale code \"en\"".
+	Reason string
+}
+
+func (d *FormatDetection) String() string {
+	return fmt.Sprintf("%s (%s)", d.Format, d.Reason)
+}
+
+// specFormatExtensions maps file extensions to the formats of the API spec
+// using them, for hosts whose formats aren't known.
+var specFormatExtensions = map[string][]FileFormat{
+	"yml":         {FormatYML, FormatYMLSymfony, FormatYMLSymfony2},
+	"json":        {FormatSimpleJSON, FormatNestedJSON, FormatI18next, FormatReactSimpleJSON, FormatReactNestedJSON, FormatNodeJSON, FormatGoI18n, FormatAngularTranslate},
+	"po":          {FormatGettext},
+	"pot":         {FormatGettextTemplate},
+	"xml":         {FormatAndroidXML, FormatPropertiesXML},
+	"strings":     {FormatIOSStrings},
+	"stringsdict": {FormatIOSStringsdict},
+	"properties":  {FormatProperties, FormatMozillaProperties},
+	"xlf":         {FormatXLIFF},
+	"csv":         {FormatCSV, FormatZendeskCSV},
+	"xlsx":        {FormatXLSX},
+	"resx":        {FormatRESX, FormatRESXWindowsPhone},
+	"resw":        {FormatWindows8Resource},
+	"ini":         {FormatINI},
+	"plist":       {FormatPlist},
+	"tmx":         {FormatTMX},
+	"ts":          {FormatQtTranslationTS},
+	"qph":         {FormatQtPhraseBook},
+	"php":         {FormatPHPArray, FormatLaravel},
+	"js":          {FormatEmberJS},
+}
+
+// extensionAliases maps alternative file extensions to those of formats.
+var extensionAliases = map[string]string{"yaml": "yml", "xliff": "xlf"}
+
+// Detect reads the file at path and detects its format among the
+// importable formats of the host, see DetectFormat.
+func (r *FormatRegistry) Detect(path string) (*FormatDetection, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	formats, err := r.Formats()
+	if err != nil {
+		return nil, err
+	}
+	return DetectFormat(path, content, formats)
+}
+
+// DetectFormat picks the format of a file to upload among the importable
+// formats, or those of the API spec if formats is nil. The content decides
+// where it tells formats of the same extension apart: simple_json,
+// nested_json, i18next and go_i18n files, yml and yml_symfony files, strings
+// and stringsdict files, and Android, .NET and other XML files. Otherwise
+// the file name is matched against the DefaultFile of the formats, and
+// extensions used by a single format decide.
+func DetectFormat(path string, content []byte, formats []*Format) (*FormatDetection, error) {
+	ext := strings.ToLower(strings.TrimPrefix(filepath.Ext(path), "."))
+	if alias, found := extensionAliases[ext]; found {
+		ext = alias
+	}
+
+	var candidates []*Format
+	if formats == nil {
+		for _, name := range specFormatExtensions[ext] {
+			candidates = append(candidates, &Format{ApiName: string(name), Extension: ext, Importable: true})
+		}
+	} else {
+		for _, f := range formats {
+			if f.Importable && strings.EqualFold(f.Extension, ext) {
+				candidates = append(candidates, f)
+			}
+		}
+	}
+
+	if format, reason := sniffFormat(ext, content); format != "" {
+		if formats == nil || isImportable(formats, format) {
+			return &FormatDetection{Format: format, Reason: reason}, nil
+		}
+	}
+
+	// Default files named exactly like the file come before patterns.
+	var exact, matching []*Format
+	for _, f := range candidates {
+		if !matchesDefaultFile(f.DefaultFile, path) {
+			continue
+		}
+		matching = append(matching, f)
+		if filepath.Base(f.DefaultFile) == filepath.Base(path) {
+			exact = append(exact, f)
+		}
+	}
+	if len(exact) == 1 {
+		matching = exact
+	}
+	if len(matching) == 1 {
+		f := matching[0]
+		return &FormatDetection{Format: FileFormat(f.ApiName), Reason: fmt.Sprintf("file name matches the default file %s", f.DefaultFile)}, nil
+	}
+
+	switch len(candidates) {
+	case 0:
+		return nil, fmt.Errorf("%s: no importable file format with extension %q", path, ext)
+	case 1:
+		return &FormatDetection{Format: FileFormat(candidates[0].ApiName), Reason: fmt.Sprintf("only format with extension %q", ext)}, nil
+	}
+	names := make([]string, 0, len(candidates))
+	for _, f := range candidates {
+		names = append(names, f.ApiName)
+	}
+	sort.Strings(names)
+	return nil, fmt.Errorf("%s: can't tell the file format from the content, it's one of %s", path, strings.Join(names, ", "))
+}
+
+func isImportable(formats []*Format, name FileFormat) bool {
+	for _, f := range formats {
+		if f.ApiName == string(name) {
+			return f.Importable
+		}
+	}
+	return false
+}
+
+var placeholderPattern = regexp.MustCompile(`<[a-z_]+>`)
+
+// matchesDefaultFile tells whether the name of the file at path matches
+// the one of the default file pattern, e.g. "./<locale_name>.lproj/
+// Localizable.strings".
+func matchesDefaultFile(defaultFile, path string) bool {
+	if defaultFile == "" {
+		return false
+	}
+	pattern := placeholderPattern.ReplaceAllString(filepath.Base(defaultFile), "*")
+	matched, err := filepath.Match(pattern, filepath.Base(path))
+	return err == nil && matched
+}
+
+var (
+	utf8BOM             = []byte("\xef\xbb\xbf")
+	stringsEntryPattern = regexp.MustCompile(`(?m)^\s*"(?:[^"\\]|\\.)*"\s*=\s*"`)
+	i18nextKeyPattern   = regexp.MustCompile(`_(plural|zero|one|two|few|many|other)$`)
+)
+
+// sniffFormat returns the format the content is in and why, or "" if it
+// doesn't tell.
+func sniffFormat(ext string, content []byte) (FileFormat, string) {
+	content = bytes.TrimSpace(bytes.TrimPrefix(content, utf8BOM))
+	switch {
+	case bytes.HasPrefix(content, []byte("<")):
+		return sniffXML(ext, content)
+	case ext == "json":
+		return sniffJSON(content)
+	case ext == "yml":
+		return sniffYAML(content)
+	case ext == "strings" && stringsEntryPattern.Match(content):
+		return FormatIOSStrings, `entries are "key" = "value"; pairs`
+	}
+	return "", ""
+}
+
+// xmlRootFormats maps root elements of XML files to their format.
+var xmlRootFormats = map[string]struct {
+	Format FileFormat
+	Kind   string
+}{
+	"resources":  {FormatAndroidXML, "Android string resources"},
+	"properties": {FormatPropertiesXML, "Java XML properties"},
+	"xliff":      {FormatXLIFF, "XLIFF files"},
+	"TS":         {FormatQtTranslationTS, "Qt translation sources"},
+	"QPH":        {FormatQtPhraseBook, "Qt phrase books"},
+	"tmx":        {FormatTMX, "translation memories"},
+}
+
+func sniffXML(ext string, content []byte) (FileFormat, string) {
+	dec := xml.NewDecoder(bytes.NewReader(content))
+	var root string
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return "", ""
+		}
+		el, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if root == "" {
+			root = el.Name.Local
+			if known, found := xmlRootFormats[root]; found {
+				return known.Format, fmt.Sprintf("root element is <%s>, as in %s", root, known.Kind)
+			}
+			if root != "root" && root != "plist" {
+				return "", ""
+			}
+			continue
+		}
+
+		switch {
+		case root == "root" && el.Name.Local == "data":
+			if ext == "resw" {
+				return FormatWindows8Resource, "root element is <root> with <data> elements, as in .NET resources, and the extension is resw"
+			}
+			return FormatRESX, "root element is <root> with <data> elements, as in .NET resources"
+		case root == "plist" && el.Name.Local == "key":
+			var key string
+			if err := dec.DecodeElement(&key, &el); err == nil && key == "NSStringLocalizedFormatKey" {
+				return FormatIOSStringsdict, "property list with NSStringLocalizedFormatKey entries, as in stringsdict files"
+			}
+		}
+	}
+}
+
+func sniffJSON(content []byte) (FileFormat, string) {
+	var v interface{}
+	if err := json.Unmarshal(content, &v); err != nil {
+		return "", ""
+	}
+
+	switch v := v.(type) {
+	case []interface{}:
+		for _, item := range v {
+			m, ok := item.(map[string]interface{})
+			if !ok || m["id"] == nil || m["translation"] == nil {
+				return "", ""
+			}
+		}
+		if len(v) > 0 {
+			return FormatGoI18n, "array of objects with id and translation, as in go-i18n files"
+		}
+	case map[string]interface{}:
+		if reason := i18nextMarker("", v); reason != "" {
+			return FormatI18next, reason
+		}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if _, ok := v[key].(map[string]interface{}); ok {
+				return FormatNestedJSON, fmt.Sprintf("value of %q is a nested object", key)
+			}
+		}
+		return FormatSimpleJSON, "flat object of keys and values"
+	}
+	return "", ""
+}
+
+// i18nextMarker returns why the object looks like an i18next file, i.e. has
+// plural forms of keys or {{interpolations}}, or "".
+func i18nextMarker(prefix string, obj map[string]interface{}) string {
+	keys := make([]string, 0, len(obj))
+	plurals := map[string]int{}
+	for key := range obj {
+		keys = append(keys, key)
+		if m := i18nextKeyPattern.FindStringSubmatchIndex(key); m != nil {
+			plurals[key[:m[0]]]++
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		switch value := obj[key].(type) {
+		case map[string]interface{}:
+			if reason := i18nextMarker(prefix+key+".", value); reason != "" {
+				return reason
+			}
+		case string:
+			// A plural form next to the key or another form of it.
+			if m := i18nextKeyPattern.FindStringSubmatchIndex(key); m != nil {
+				if base := key[:m[0]]; obj[base] != nil || plurals[base] > 1 {
+					return fmt.Sprintf("key %q is an i18next plural form", prefix+key)
+				}
+			}
+			if strings.Contains(value, "{{") || strings.Contains(value, "$t(") {
+				return fmt.Sprintf("value of %q uses i18next interpolation", prefix+key)
+			}
+		}
+	}
+	return ""
+}
+
+// languageCodes are the ISO 639-1 language codes, the first part of the
+// locale codes Rails files are nested below.
+var languageCodes = map[string]bool{}
+
+func init() {
+	for _, code := range strings.Fields(`aa ab ae af ak am an ar as av ay az ba be bg bh bi bm bn bo br bs ca
+		ce ch co cr cs cu cv cy da de dv dz ee el en eo es et eu fa ff fi fj fo fr fy ga gd gl gn gu gv
+		ha he hi ho hr ht hu hy hz ia id ie ig ii ik io is it iu ja jv ka kg ki kj kk kl km kn ko kr ks
+		ku kv kw ky la lb lg li ln lo lt lu lv mg mh mi mk ml mn mr ms mt my na nb nd ne ng nl nn no nr
+		nv ny oc oj om or os pa pi pl ps pt qu rm rn ro ru rw sa sc sd se sg si sk sl sm sn so sq sr ss
+		st su sv sw ta te tg th ti tk tl tn to tr ts tt tw ty ug uk ur uz ve vi vo wa wo xh yi yo za zh zu`) {
+		languageCodes[code] = true
+	}
+}
+
+// sniffYAML tells Rails files, nested below a single locale code, from
+// Symfony files. A single key looking like a locale code of an unknown
+// language, e.g. "app" or "nav", doesn't tell.
+func sniffYAML(content []byte) (FileFormat, string) {
+	var v map[interface{}]interface{}
+	if err := yaml.Unmarshal(content, &v); err != nil || len(v) == 0 {
+		return "", ""
+	}
+	if len(v) == 1 {
+		for key, value := range v {
+			s, ok := key.(string)
+			if _, nested := value.(map[interface{}]interface{}); !ok || !nested || !localeCodePattern.MatchString(s) {
+				break
+			}
+			if language := strings.ToLower(strings.FieldsFunc(s, isLocaleCodeSeparator)[0]); !languageCodes[language] {
+				return "", ""
+			}
+			return FormatYML, fmt.Sprintf("keys are nested below the locale code %q, as in Rails", s)
+		}
+	}
+	return FormatYMLSymfony, "keys aren't nested below a locale code, as in Symfony"
+}
+
+func isLocaleCodeSeparator(r rune) bool {
+	return r == '-' || r == '_'
+}
+
+// detectUploadFormat returns params with the detected format of the file if
+// they have none, see DetectFormat. The params passed aren't changed. If the
+// format can't be detected, the host picks it, i.e. the project's main
+// format.
+func (client *Client) detectUploadFormat(params *UploadParams) *UploadParams {
+	if params == nil || params.FileFormat != nil || params.File == nil {
+		return params
+	}
+	content, err := ioutil.ReadFile(*params.File)
+	if err != nil {
+		// Reported when uploading.
+		return params
+	}
+	formats, err := client.formatRegistry().Formats()
+	if err != nil {
+		formats = nil
+	}
+
+	logger := client.logger()
+	detection, err := DetectFormat(*params.File, content, formats)
+	if err != nil {
+		logger.Log(LevelDebug, "format detection failed", LogField{"file", *params.File}, LogField{"error", err})
+		return params
+	}
+	logger.Log(LevelInfo, "format detected", LogField{"file", *params.File}, LogField{"format", detection.Format}, LogField{"reason", detection.Reason})
+
+	detected := *params
+	detected.FileFormat = &detection.Format
+	return &detected
+}
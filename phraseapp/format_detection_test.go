@@ -0,0 +1,131 @@
+package phraseapp
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		path    string
+		content string
+		format  FileFormat
+	}{
+		{"en.json", `{"hello": "Hello", "bye": "Bye"}`, FormatSimpleJSON},
+		{"en.json", `{"home": {"title": "Home"}}`, FormatNestedJSON},
+		{"en.json", `{"item": "one item", "item_plural": "{{count}} items"}`, FormatI18next},
+		{"en.json", `{"cart": {"item_one": "one item", "item_other": "items"}}`, FormatI18next},
+		{"en.json", `{"button_other": "Other"}`, FormatSimpleJSON},
+		{"en.json", `[{"id": "hello", "translation": "Hello"}]`, FormatGoI18n},
+		{"en.yml", "en:\n  hello: Hello\n", FormatYML},
+		{"pt.yml", "pt-BR:\n  hello: Olá\n", FormatYML},
+		{"en.yml", "home:\n  title: Home\n", FormatYMLSymfony},
+		{"messages.en.yaml", "hello: Hello\nbye: Bye\n", FormatYMLSymfony},
+		{"Localizable.strings", "/* greeting */\n\"hello\" = \"Hello\";\n", FormatIOSStrings},
+		{"Localizable.stringsdict", `<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0"><dict><key>items</key><dict>
+<key>NSStringLocalizedFormatKey</key><string>%#@items@</string>
+</dict></dict></plist>`, FormatIOSStringsdict},
+		{"strings.xml", "\xef\xbb\xbf<?xml version=\"1.0\"?>\n<resources><string name=\"hello\">Hello</string></resources>", FormatAndroidXML},
+		{"Strings.xml", `<root><data name="hello"><value>Hello</value></data></root>`, FormatRESX},
+		{"de.po", "msgid \"hello\"\nmsgstr \"Hallo\"\n", FormatGettext},
+	}
+	for _, tt := range tests {
+		detection, err := DetectFormat(tt.path, []byte(tt.content), nil)
+		if err != nil {
+			t.Errorf("%s: didn't expect an error, got %q", tt.content, err)
+			continue
+		}
+		if detection.Format != tt.format || detection.Reason == "" {
+			t.Errorf("%s: expected format %s, got %s", tt.content, tt.format, detection)
+		}
+	}
+
+	if _, err := DetectFormat("en.txt", []byte("hello"), nil); err == nil || err.Error() != `en.txt: no importable file format with extension "txt"` {
+		t.Errorf("expected an unknown extension error, got %v", err)
+	}
+	if _, err := DetectFormat("en.yml", []byte("app:\n  title: App\n"), nil); err == nil || !strings.HasSuffix(err.Error(), "it's one of yml, yml_symfony, yml_symfony2") {
+		t.Errorf("expected a nested key not being a locale code not to tell, got %v", err)
+	}
+	detection, err := DetectFormat("en.json", []byte(`{"b": {"x": "X"}, "a": {"y": "Y"}, "c": "C"}`), nil)
+	if err != nil || detection.Reason != `value of "a" is a nested object` {
+		t.Errorf("expected the first nested key in order, got %v (%v)", detection, err)
+	}
+	if _, err := DetectFormat("en.csv", []byte("key,en\n"), nil); err == nil || !strings.HasSuffix(err.Error(), "it's one of csv, zendesk_csv") {
+		t.Errorf("expected an ambiguous format error, got %v", err)
+	}
+}
+
+func TestDetectFormatOfHost(t *testing.T) {
+	formats := []*Format{
+		{ApiName: "yml", Extension: "yml", Importable: true},
+		{ApiName: "nested_json", Extension: "json", Importable: true},
+		{ApiName: "csv", Extension: "csv", DefaultFile: "./locales/<locale_name>.csv", Importable: true},
+		{ApiName: "zendesk_csv", Extension: "csv", DefaultFile: "./zendesk.csv", Importable: true},
+	}
+
+	// Formats the host doesn't know aren't detected.
+	detection, err := DetectFormat("en.json", []byte(`{"hello": "Hello"}`), formats)
+	if err != nil || detection.Format != FormatNestedJSON || detection.Reason != `only format with extension "json"` {
+		t.Errorf("expected nested_json for the only json format, got %v (%v)", detection, err)
+	}
+	detection, err = DetectFormat("./out/zendesk.csv", []byte("key,en\n"), formats)
+	if err != nil || detection.Format != FormatZendeskCSV || detection.Reason != "file name matches the default file ./zendesk.csv" {
+		t.Errorf("expected zendesk_csv by its default file, got %v (%v)", detection, err)
+	}
+}
+
+func TestUploadCreateDetectsFormat(t *testing.T) {
+	var uploaded string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/formats":
+			io.WriteString(w, `[{"api_name":"yml","extension":"yml","importable":true},{"api_name":"yml_symfony","extension":"yml","importable":true}]`)
+		case "/v2/projects/p1/uploads":
+			uploaded = r.FormValue("file_format")
+			w.WriteHeader(http.StatusCreated)
+			io.WriteString(w, `{"id":"u1","state":"processing"}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	logger := &testLogger{level: LevelInfo}
+	client, _ := NewClient(Credentials{Host: server.URL, Token: "secret"}, false)
+	client.Logger = logger
+
+	dir, err := ioutil.TempDir("", "phraseapp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "messages.de.yml")
+	ioutil.WriteFile(file, []byte("hello: Hallo\n"), 0600)
+
+	params := &UploadParams{File: &file}
+	upload, err := client.UploadCreate("p1", params)
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if uploaded != "yml_symfony" || upload.State != UploadStateProcessing {
+		t.Errorf("expected a yml_symfony upload, got %q", uploaded)
+	}
+	if params.FileFormat != nil {
+		t.Errorf("expected the params not to be changed")
+	}
+	var reason interface{}
+	for _, e := range logger.entries {
+		if e.msg == "format detected" {
+			reason = e.fields["reason"]
+		}
+	}
+	if reason != "keys aren't nested below a locale code, as in Symfony" {
+		t.Errorf("expected the detection to be logged, got %+v", logger.entries)
+	}
+}
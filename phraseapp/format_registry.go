@@ -0,0 +1,154 @@
+package phraseapp
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// FormatRegistry caches the file formats of FormatsList, fetched on first
+// use. Failed fetches are retried on the next call.
+type FormatRegistry struct {
+	client *Client
+
+	mu      sync.Mutex
+	formats []*Format
+	// failed is set when fetching the formats for validation failed.
+	failed bool
+}
+
+// NewFormatRegistry returns a registry of the formats of the client's host.
+func NewFormatRegistry(client *Client) *FormatRegistry {
+	return &FormatRegistry{client: client}
+}
+
+// Formats returns all formats of the host.
+func (r *FormatRegistry) Formats() ([]*Format, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.formats != nil {
+		return r.formats, nil
+	}
+
+	formats := []*Format{}
+	for page := 1; ; page++ {
+		list, err := r.client.FormatsList(page, 100)
+		if err != nil {
+			return nil, err
+		}
+		formats = append(formats, list...)
+		if len(list) < 100 {
+			break
+		}
+	}
+	r.formats = formats
+	return formats, nil
+}
+
+// Reset drops the cached formats, so they are fetched again.
+func (r *FormatRegistry) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.formats = nil
+	r.failed = false
+}
+
+// validationFormats returns the API names of the formats to validate params
+// with, or nil if they can't be fetched. Unlike Formats, a failed fetch
+// isn't retried until Reset, so validation doesn't slow down every call.
+func (r *FormatRegistry) validationFormats() []string {
+	r.mu.Lock()
+	failed := r.failed
+	r.mu.Unlock()
+	if failed {
+		return nil
+	}
+
+	formats, err := r.Formats()
+	if err != nil {
+		r.mu.Lock()
+		r.failed = true
+		r.mu.Unlock()
+		return nil
+	}
+	names := make([]string, 0, len(formats))
+	for _, f := range formats {
+		names = append(names, f.ApiName)
+	}
+	return names
+}
+
+// Format returns the format with the API name, or an error if the host
+// doesn't know it.
+func (r *FormatRegistry) Format(name FileFormat) (*Format, error) {
+	formats, err := r.Formats()
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range formats {
+		if f.ApiName == string(name) {
+			return f, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown file format %q", name)
+}
+
+// Extension returns the file extension of the format, without dot, e.g.
+// "json" for nested_json.
+func (r *FormatRegistry) Extension(name FileFormat) (string, error) {
+	f, err := r.Format(name)
+	if err != nil {
+		return "", err
+	}
+	return f.Extension, nil
+}
+
+// Importable tells whether files of the format can be uploaded.
+func (r *FormatRegistry) Importable(name FileFormat) (bool, error) {
+	f, err := r.Format(name)
+	if err != nil {
+		return false, err
+	}
+	return f.Importable, nil
+}
+
+// Exportable tells whether locales can be downloaded in the format.
+func (r *FormatRegistry) Exportable(name FileFormat) (bool, error) {
+	f, err := r.Format(name)
+	if err != nil {
+		return false, err
+	}
+	return f.Exportable, nil
+}
+
+// ForExtension returns the formats of files with the extension, e.g.
+// yml, yml_symfony and yml_symfony2 for "yml". A leading dot is ignored.
+func (r *FormatRegistry) ForExtension(extension string) ([]*Format, error) {
+	formats, err := r.Formats()
+	if err != nil {
+		return nil, err
+	}
+	extension = strings.ToLower(strings.TrimPrefix(extension, "."))
+	var matches []*Format
+	for _, f := range formats {
+		if strings.ToLower(f.Extension) == extension {
+			matches = append(matches, f)
+		}
+	}
+	return matches, nil
+}
+
+// formatRegistryMu guards setting the Formats of clients not created by
+// NewClient.
+var formatRegistryMu sync.Mutex
+
+// formatRegistry returns the client's Formats, set to a new registry for
+// clients not created by NewClient.
+func (client *Client) formatRegistry() *FormatRegistry {
+	formatRegistryMu.Lock()
+	defer formatRegistryMu.Unlock()
+	if client.Formats == nil {
+		client.Formats = NewFormatRegistry(client)
+	}
+	return client.Formats
+}
@@ -0,0 +1,49 @@
+package phraseapp
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFormatRegistry(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		io.WriteString(w, `[
+			{"api_name":"yml","extension":"yml","importable":true,"exportable":true},
+			{"api_name":"yml_symfony","extension":"yml","importable":true,"exportable":true},
+			{"api_name":"nested_json","extension":"json","importable":true,"exportable":true},
+			{"api_name":"xlsx","extension":"xlsx","importable":true,"exportable":false}
+		]`)
+	}))
+	defer server.Close()
+	client, _ := NewClient(Credentials{Host: server.URL, Token: "secret"}, false)
+	registry := client.Formats
+
+	if ext, err := registry.Extension(FormatNestedJSON); err != nil || ext != "json" {
+		t.Errorf("expected extension json, got %q (%v)", ext, err)
+	}
+	if exportable, err := registry.Exportable(FormatXLSX); err != nil || exportable {
+		t.Errorf("expected xlsx not to be exportable, got %v (%v)", exportable, err)
+	}
+	if importable, err := registry.Importable(FormatXLSX); err != nil || !importable {
+		t.Errorf("expected xlsx to be importable, got %v (%v)", importable, err)
+	}
+	if _, err := registry.Importable(FormatGettext); err == nil || err.Error() != `unknown file format "gettext"` {
+		t.Errorf("expected an unknown format error, got %v", err)
+	}
+	formats, err := registry.ForExtension(".YML")
+	if err != nil || len(formats) != 2 || formats[0].ApiName != "yml" || formats[1].ApiName != "yml_symfony" {
+		t.Errorf("expected the yml formats, got %v (%v)", formats, err)
+	}
+	if requests != 1 {
+		t.Errorf("expected the formats to be fetched once, got %d requests", requests)
+	}
+
+	registry.Reset()
+	if _, err := registry.Formats(); err != nil || requests != 2 {
+		t.Errorf("expected the formats to be fetched again, got %d requests (%v)", requests, err)
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -17,7 +16,7 @@ import (
 
 type httpCacheClient struct {
 	cache        *diskv.Diskv
-	debug        bool
+	logger       Logger
 	cacheSizeMax int64
 }
 
@@ -42,13 +41,17 @@ type httpResponse struct {
 	Trailer          http.Header
 }
 
+// cacheHeader is set to "hit" on responses served from the cache.
+const cacheHeader = "X-Phraseapp-Cache"
+
 // CacheConfig contains the configuration for caching api requests on disk
 type CacheConfig struct {
 	CacheDir     string
-	CacheSizeMax int64 // size in bytes
+	CacheSizeMax int64  // size in bytes
+	Logger       Logger // defaults to the logger of the client
 }
 
-func newHTTPCacheClient(debug bool, config CacheConfig) (*httpCacheClient, error) {
+func newHTTPCacheClient(logger Logger, config CacheConfig) (*httpCacheClient, error) {
 	if config.CacheDir == "" {
 		cacheDir, err := os.UserCacheDir()
 		if err != nil {
@@ -72,7 +75,7 @@ func newHTTPCacheClient(debug bool, config CacheConfig) (*httpCacheClient, error
 			BasePath: cachePath,
 		}),
 		cacheSizeMax: config.CacheSizeMax,
-		debug:        debug,
+		logger:       logger,
 	}
 	return cache, nil
 }
@@ -82,8 +85,12 @@ func (client *httpCacheClient) RoundTrip(req *http.Request) (*http.Response, err
 		return http.DefaultTransport.RoundTrip(req)
 	}
 
+	_, span := startChildSpan(req.Context(), "cache")
+	defer span.End()
+
 	cacheKey := cacheKey(req)
 	cachedResponse, err := client.readCache(cacheKey)
+	span.SetAttribute("cached", err == nil)
 	if err != nil {
 		if err.Error() != "no cache entry" {
 			return nil, err
@@ -99,9 +106,8 @@ func (client *httpCacheClient) RoundTrip(req *http.Request) (*http.Response, err
 	defer rsp.Body.Close()
 
 	if rsp.StatusCode == http.StatusNotModified {
-		if client.debug {
-			log.Println("found cache and returning cached body")
-		}
+		client.logger.Log(LevelDebug, "cache hit", LogField{"path", req.URL.Path}, LogField{"etag", cachedResponse.ETag}, LogField{"not_modified", true})
+		span.SetAttribute("hit", true)
 		cachedResponse.setCachedResponse(rsp)
 		return rsp, nil
 	}
@@ -149,9 +155,7 @@ func requestParams(req *http.Request) string {
 func (client *httpCacheClient) readCache(cacheKey string) (*cacheRecord, error) {
 	cache, err := client.cache.Read(cacheKey)
 	if err != nil {
-		if client.debug {
-			log.Println("doing request without etag")
-		}
+		client.logger.Log(LevelDebug, "cache miss", LogField{"key", cacheKey})
 		return nil, fmt.Errorf("no cache entry")
 	}
 
@@ -163,9 +167,7 @@ func (client *httpCacheClient) readCache(cacheKey string) (*cacheRecord, error)
 	if err != nil {
 		return nil, err
 	}
-	if client.debug {
-		log.Printf("found etag %s for request\n", cachedResponse.ETag)
-	}
+	client.logger.Log(LevelDebug, "cache revalidate", LogField{"url", cachedResponse.URL}, LogField{"etag", cachedResponse.ETag})
 
 	return cachedResponse, nil
 }
@@ -205,7 +207,8 @@ func (record *cacheRecord) setCachedResponse(rsp *http.Response) {
 	rsp.Proto = record.Response.Proto
 	rsp.ProtoMajor = record.Response.ProtoMajor
 	rsp.ProtoMinor = record.Response.ProtoMinor
-	rsp.Header = record.Response.Header
+	rsp.Header = record.Response.Header.Clone()
+	rsp.Header.Set(cacheHeader, "hit")
 	rsp.ContentLength = record.Response.ContentLength
 	rsp.TransferEncoding = record.Response.TransferEncoding
 	rsp.Trailer = record.Response.Header
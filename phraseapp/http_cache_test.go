@@ -31,7 +31,11 @@ func TestLocaleDownloadCaching(t *testing.T) {
 	}))
 	defer server.Close()
 
-	client, _ := NewClient(Credentials{Host: server.URL}, false)
+	var cacheHits []bool
+	client, _ := NewClient(Credentials{Host: server.URL, Token: "secret"}, false)
+	client.Metrics = MetricsFunc(func(m *RequestMetrics) {
+		cacheHits = append(cacheHits, m.CacheHit)
+	})
 	cacheDir, _ := ioutil.TempDir("", "")
 	client.EnableCaching(CacheConfig{
 		CacheDir: cacheDir,
@@ -42,4 +46,7 @@ func TestLocaleDownloadCaching(t *testing.T) {
 	if string(originalContent) != string(cachedContent) {
 		t.Error("Cached content does not match original content")
 	}
+	if len(cacheHits) != 2 || cacheHits[0] || !cacheHits[1] {
+		t.Errorf("expected only the second request to be a cache hit, got %v", cacheHits)
+	}
 }
@@ -5,12 +5,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"io/ioutil"
 	"mime/multipart"
 	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -84,7 +84,7 @@ type Branch struct {
 	MergedAt  *time.Time   `json:"merged_at"`
 	MergedBy  *UserPreview `json:"merged_by"`
 	Name      string       `json:"name"`
-	State     string       `json:"state"`
+	State     BranchState  `json:"state"`
 	UpdatedAt *time.Time   `json:"updated_at"`
 }
 
@@ -175,7 +175,7 @@ type Job struct {
 	DueDate   *time.Time `json:"due_date"`
 	ID        string     `json:"id"`
 	Name      string     `json:"name"`
-	State     string     `json:"state"`
+	State     JobState   `json:"state"`
 	UpdatedAt *time.Time `json:"updated_at"`
 }
 
@@ -243,11 +243,12 @@ type LocaleStatistics struct {
 }
 
 type Member struct {
-	Email    string            `json:"email"`
-	ID       string            `json:"id"`
-	Projects []*ProjectLocales `json:"projects"`
-	Role     string            `json:"role"`
-	Username string            `json:"username"`
+	Email       string            `json:"email"`
+	ID          string            `json:"id"`
+	Permissions map[string]string `json:"permissions"`
+	Projects    []*ProjectLocales `json:"projects"`
+	Role        string            `json:"role"`
+	Username    string            `json:"username"`
 }
 
 type Project struct {
@@ -492,7 +493,7 @@ type Upload struct {
 	Filename  string      `json:"filename"`
 	Format    string      `json:"format"`
 	ID        string      `json:"id"`
-	State     string      `json:"state"`
+	State     UploadState `json:"state"`
 	Summary   SummaryType `json:"summary"`
 	Tag       string      `json:"tag"`
 	UpdatedAt *time.Time  `json:"updated_at"`
@@ -515,13 +516,13 @@ type UserPreview struct {
 }
 
 type Webhook struct {
-	Active      bool       `json:"active"`
-	CallbackUrl string     `json:"callback_url"`
-	CreatedAt   *time.Time `json:"created_at"`
-	Description string     `json:"description"`
-	Events      []string   `json:"events"`
-	ID          string     `json:"id"`
-	UpdatedAt   *time.Time `json:"updated_at"`
+	Active      bool           `json:"active"`
+	CallbackUrl string         `json:"callback_url"`
+	CreatedAt   *time.Time     `json:"created_at"`
+	Description string         `json:"description"`
+	Events      []WebhookEvent `json:"events"`
+	ID          string         `json:"id"`
+	UpdatedAt   *time.Time     `json:"updated_at"`
 }
 
 type AuthorizationParams struct {
@@ -2211,7 +2212,7 @@ type UploadParams struct {
 	ConvertEmoji       *bool             `json:"convert_emoji,omitempty"  cli:"opt --convert-emoji"`
 	File               *string           `json:"file,omitempty"  cli:"opt --file"`
 	FileEncoding       *string           `json:"file_encoding,omitempty"  cli:"opt --file-encoding"`
-	FileFormat         *string           `json:"file_format,omitempty"  cli:"opt --file-format"`
+	FileFormat         *FileFormat       `json:"file_format,omitempty"  cli:"opt --file-format"`
 	FormatOptions      map[string]string `json:"format_options,omitempty"  cli:"opt --format-options"`
 	LocaleID           *string           `json:"locale_id,omitempty"  cli:"opt --locale-id"`
 	LocaleMapping      map[string]string `json:"locale_mapping,omitempty"  cli:"opt --locale-mapping"`
@@ -2266,7 +2267,8 @@ func (params *UploadParams) ApplyValuesFromMap(defaults map[string]interface{})
 			if !ok {
 				return fmt.Errorf(cfgValueErrStr, k, v)
 			}
-			params.FileFormat = &val
+			format := FileFormat(val)
+			params.FileFormat = &format
 
 		case "format_options":
 			rval, err := ValidateIsRawMap(k, v)
@@ -2367,7 +2369,7 @@ func (params *UploadParams) QueryParams() map[string]string {
 	}
 
 	if params.FileFormat != nil && *params.FileFormat != "" {
-		queryParams["file_format"] = *params.FileFormat
+		queryParams["file_format"] = string(*params.FileFormat)
 	}
 
 	for key, value := range convertMapToQueryParams("format_options", params.FormatOptions) {
@@ -2406,10 +2408,10 @@ func (params *UploadParams) QueryParams() map[string]string {
 }
 
 type WebhookParams struct {
-	Active      *bool   `json:"active,omitempty"  cli:"opt --active"`
-	CallbackUrl *string `json:"callback_url,omitempty"  cli:"opt --callback-url"`
-	Description *string `json:"description,omitempty"  cli:"opt --description"`
-	Events      *string `json:"events,omitempty"  cli:"opt --events"`
+	Active      *bool         `json:"active,omitempty"  cli:"opt --active"`
+	CallbackUrl *string       `json:"callback_url,omitempty"  cli:"opt --callback-url"`
+	Description *string       `json:"description,omitempty"  cli:"opt --description"`
+	Events      WebhookEvents `json:"events,omitempty"  cli:"opt --events"`
 }
 
 func (params *WebhookParams) ApplyValuesFromMap(defaults map[string]interface{}) error {
@@ -2437,11 +2439,24 @@ func (params *WebhookParams) ApplyValuesFromMap(defaults map[string]interface{})
 			params.Description = &val
 
 		case "events":
-			val, ok := v.(string)
-			if !ok {
+			switch val := v.(type) {
+			case string:
+				params.Events = ParseWebhookEvents(val)
+			case []string:
+				params.Events = ParseWebhookEvents(strings.Join(val, ","))
+			case []interface{}:
+				events := make(WebhookEvents, 0, len(val))
+				for _, item := range val {
+					s, ok := item.(string)
+					if !ok {
+						return fmt.Errorf(cfgValueErrStr, k, v)
+					}
+					events = append(events, WebhookEvent(s))
+				}
+				params.Events = events
+			default:
 				return fmt.Errorf(cfgValueErrStr, k, v)
 			}
-			params.Events = &val
 
 		default:
 			return fmt.Errorf(cfgInvalidKeyErrStr, k)
@@ -2466,8 +2481,8 @@ func (params *WebhookParams) QueryParams() map[string]string {
 		queryParams["description"] = *params.Description
 	}
 
-	if params.Events != nil && *params.Events != "" {
-		queryParams["events"] = *params.Events
+	if len(params.Events) > 0 {
+		queryParams["events"] = params.Events.String()
 	}
 
 	return queryParams
@@ -2476,61 +2491,55 @@ func (params *WebhookParams) QueryParams() map[string]string {
 // Get details on a single account.
 func (client *Client) AccountShow(id string) (*AccountDetails, error) {
 	retVal := new(AccountDetails)
+	ctx, span := client.startSpan("AccountShow", "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s", url.QueryEscape(id))
 
-		rc, err := client.sendRequest("GET", url, "", nil, 200)
+		rc, err := client.sendRequest(ctx, "GET", url, "", nil, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // List all accounts the current user has access to.
 func (client *Client) AccountsList(page, perPage int) ([]*Account, error) {
 	retVal := []*Account{}
+	ctx, span := client.startSpan("AccountsList")
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts")
 
-		rc, err := client.sendRequestPaginated("GET", url, "", nil, 200, page, perPage)
+		rc, err := client.sendRequestPaginated(ctx, "GET", url, "", nil, 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Create a new authorization.
 func (client *Client) AuthorizationCreate(params *AuthorizationParams) (*AuthorizationWithToken, error) {
 	retVal := new(AuthorizationWithToken)
+	ctx, span := client.startSpan("AuthorizationCreate")
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/authorizations")
 
@@ -2540,34 +2549,30 @@ func (client *Client) AuthorizationCreate(params *AuthorizationParams) (*Authori
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Delete an existing authorization. API calls using that token will stop working.
 func (client *Client) AuthorizationDelete(id string) error {
 
+	ctx, span := client.startSpan("AuthorizationDelete", "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/authorizations/%s", url.QueryEscape(id))
 
-		rc, err := client.sendRequest("DELETE", url, "", nil, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "", nil, 204)
 
 		if err != nil {
 			return err
@@ -2576,40 +2581,40 @@ func (client *Client) AuthorizationDelete(id string) error {
 
 		return nil
 	}()
+	endSpan(span, err)
 	return err
 }
 
 // Get details on a single authorization.
 func (client *Client) AuthorizationShow(id string) (*Authorization, error) {
 	retVal := new(Authorization)
+	ctx, span := client.startSpan("AuthorizationShow", "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/authorizations/%s", url.QueryEscape(id))
 
-		rc, err := client.sendRequest("GET", url, "", nil, 200)
+		rc, err := client.sendRequest(ctx, "GET", url, "", nil, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Update an existing authorization.
 func (client *Client) AuthorizationUpdate(id string, params *AuthorizationParams) (*Authorization, error) {
 	retVal := new(Authorization)
+	ctx, span := client.startSpan("AuthorizationUpdate", "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/authorizations/%s", url.QueryEscape(id))
 
@@ -2619,57 +2624,51 @@ func (client *Client) AuthorizationUpdate(id string, params *AuthorizationParams
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // List all your authorizations.
 func (client *Client) AuthorizationsList(page, perPage int) ([]*Authorization, error) {
 	retVal := []*Authorization{}
+	ctx, span := client.startSpan("AuthorizationsList")
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/authorizations")
 
-		rc, err := client.sendRequestPaginated("GET", url, "", nil, 200, page, perPage)
+		rc, err := client.sendRequestPaginated(ctx, "GET", url, "", nil, 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Export translations from Phrase to Bitbucket according to the .phraseapp.yml file within the Bitbucket Repository.
 func (client *Client) BitbucketSyncExport(id string, params *BitbucketSyncParams) (*BitbucketSyncExportResponse, error) {
 	retVal := new(BitbucketSyncExportResponse)
+	ctx, span := client.startSpan("BitbucketSyncExport", "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/bitbucket_syncs/%s/export", url.QueryEscape(id))
 
@@ -2679,30 +2678,29 @@ func (client *Client) BitbucketSyncExport(id string, params *BitbucketSyncParams
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Import translations from Bitbucket to Phrase according to the .phraseapp.yml file within the Bitbucket repository.
 func (client *Client) BitbucketSyncImport(id string, params *BitbucketSyncParams) error {
 
+	ctx, span := client.startSpan("BitbucketSyncImport", "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/bitbucket_syncs/%s/import", url.QueryEscape(id))
 
@@ -2712,7 +2710,7 @@ func (client *Client) BitbucketSyncImport(id string, params *BitbucketSyncParams
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
@@ -2721,40 +2719,45 @@ func (client *Client) BitbucketSyncImport(id string, params *BitbucketSyncParams
 
 		return nil
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return err
 }
 
 // List all Bitbucket repositories for which synchronisation with Phrase is activated.
 func (client *Client) BitbucketSyncsList(page, perPage int, params *BitbucketSyncParams) ([]*BitbucketSync, error) {
 	retVal := []*BitbucketSync{}
+	ctx, span := client.startSpan("BitbucketSyncsList")
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/bitbucket_syncs")
 
-		rc, err := client.sendGetRequestPaginated(url, params.QueryParams(), 200, page, perPage)
+		rc, err := client.sendGetRequestPaginated(ctx, url, params.QueryParams(), 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Create a new rule for blacklisting keys.
 func (client *Client) BlacklistedKeyCreate(project_id string, params *BlacklistedKeyParams) (*BlacklistedKey, error) {
 	retVal := new(BlacklistedKey)
+	ctx, span := client.startSpan("BlacklistedKeyCreate", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/blacklisted_keys", url.QueryEscape(project_id))
 
@@ -2764,34 +2767,30 @@ func (client *Client) BlacklistedKeyCreate(project_id string, params *Blackliste
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Delete an existing rule for blacklisting keys.
 func (client *Client) BlacklistedKeyDelete(project_id, id string) error {
 
+	ctx, span := client.startSpan("BlacklistedKeyDelete", "project_id", project_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s/blacklisted_keys/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("DELETE", url, "", nil, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "", nil, 204)
 
 		if err != nil {
 			return err
@@ -2800,40 +2799,40 @@ func (client *Client) BlacklistedKeyDelete(project_id, id string) error {
 
 		return nil
 	}()
+	endSpan(span, err)
 	return err
 }
 
 // Get details on a single rule for blacklisting keys for a given project.
 func (client *Client) BlacklistedKeyShow(project_id, id string) (*BlacklistedKey, error) {
 	retVal := new(BlacklistedKey)
+	ctx, span := client.startSpan("BlacklistedKeyShow", "project_id", project_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s/blacklisted_keys/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("GET", url, "", nil, 200)
+		rc, err := client.sendRequest(ctx, "GET", url, "", nil, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Update an existing rule for blacklisting keys.
 func (client *Client) BlacklistedKeyUpdate(project_id, id string, params *BlacklistedKeyParams) (*BlacklistedKey, error) {
 	retVal := new(BlacklistedKey)
+	ctx, span := client.startSpan("BlacklistedKeyUpdate", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/blacklisted_keys/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -2843,61 +2842,55 @@ func (client *Client) BlacklistedKeyUpdate(project_id, id string, params *Blackl
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // List all rules for blacklisting keys for the given project.
 func (client *Client) BlacklistedKeysList(project_id string, page, perPage int) ([]*BlacklistedKey, error) {
 	retVal := []*BlacklistedKey{}
+	ctx, span := client.startSpan("BlacklistedKeysList", "project_id", project_id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s/blacklisted_keys", url.QueryEscape(project_id))
 
-		rc, err := client.sendRequestPaginated("GET", url, "", nil, 200, page, perPage)
+		rc, err := client.sendRequestPaginated(ctx, "GET", url, "", nil, 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Compare branch with main branch.
 func (client *Client) BranchCompare(project_id, name string, params *BranchParams) error {
 
+	ctx, span := client.startSpan("BranchCompare", "project_id", project_id, "name", name)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/branches/%s/compare", url.QueryEscape(project_id), url.QueryEscape(name))
 
-		rc, err := client.sendGetRequest(url, params.QueryParams(), 200)
+		rc, err := client.sendGetRequest(ctx, url, params.QueryParams(), 200)
 
 		if err != nil {
 			return err
@@ -2906,13 +2899,19 @@ func (client *Client) BranchCompare(project_id, name string, params *BranchParam
 
 		return nil
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return err
 }
 
 // Create a new branch.
 func (client *Client) BranchCreate(project_id string, params *BranchParams) (*Branch, error) {
 	retVal := new(Branch)
+	ctx, span := client.startSpan("BranchCreate", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/branches", url.QueryEscape(project_id))
 
@@ -2922,34 +2921,30 @@ func (client *Client) BranchCreate(project_id string, params *BranchParams) (*Br
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Delete an existing branch.
 func (client *Client) BranchDelete(project_id, name string) error {
 
+	ctx, span := client.startSpan("BranchDelete", "project_id", project_id, "name", name)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s/branches/%s", url.QueryEscape(project_id), url.QueryEscape(name))
 
-		rc, err := client.sendRequest("DELETE", url, "", nil, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "", nil, 204)
 
 		if err != nil {
 			return err
@@ -2958,11 +2953,12 @@ func (client *Client) BranchDelete(project_id, name string) error {
 
 		return nil
 	}()
+	endSpan(span, err)
 	return err
 }
 
 type BranchMergeParams struct {
-	Strategy *string `json:"strategy,omitempty"  cli:"opt --strategy"`
+	Strategy *BranchMergeStrategy `json:"strategy,omitempty"  cli:"opt --strategy"`
 }
 
 func (params *BranchMergeParams) ApplyValuesFromMap(defaults map[string]interface{}) error {
@@ -2973,7 +2969,8 @@ func (params *BranchMergeParams) ApplyValuesFromMap(defaults map[string]interfac
 			if !ok {
 				return fmt.Errorf(cfgValueErrStr, k, v)
 			}
-			params.Strategy = &val
+			strategy := BranchMergeStrategy(val)
+			params.Strategy = &strategy
 
 		default:
 			return fmt.Errorf(cfgInvalidKeyErrStr, k)
@@ -2987,7 +2984,7 @@ func (params *BranchMergeParams) QueryParams() map[string]string {
 	var queryParams = make(map[string]string, 0)
 
 	if params.Strategy != nil && *params.Strategy != "" {
-		queryParams["strategy"] = *params.Strategy
+		queryParams["strategy"] = string(*params.Strategy)
 	}
 
 	return queryParams
@@ -2996,7 +2993,11 @@ func (params *BranchMergeParams) QueryParams() map[string]string {
 // Merge an existing branch.
 func (client *Client) BranchMerge(project_id, name string, params *BranchMergeParams) error {
 
+	ctx, span := client.startSpan("BranchMerge", "project_id", project_id, "name", name)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/branches/%s/merge", url.QueryEscape(project_id), url.QueryEscape(name))
 
@@ -3006,7 +3007,7 @@ func (client *Client) BranchMerge(project_id, name string, params *BranchMergePa
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
@@ -3015,40 +3016,41 @@ func (client *Client) BranchMerge(project_id, name string, params *BranchMergePa
 
 		return nil
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return err
 }
 
 // Get details on a single branch for a given project.
 func (client *Client) BranchShow(project_id, name string) (*Branch, error) {
 	retVal := new(Branch)
+	ctx, span := client.startSpan("BranchShow", "project_id", project_id, "name", name)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s/branches/%s", url.QueryEscape(project_id), url.QueryEscape(name))
 
-		rc, err := client.sendRequest("GET", url, "", nil, 200)
+		rc, err := client.sendRequest(ctx, "GET", url, "", nil, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Update an existing branch.
 func (client *Client) BranchUpdate(project_id, name string, params *BranchParams) (*Branch, error) {
 	retVal := new(Branch)
+	ctx, span := client.startSpan("BranchUpdate", "project_id", project_id, "name", name)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/branches/%s", url.QueryEscape(project_id), url.QueryEscape(name))
 
@@ -3058,57 +3060,51 @@ func (client *Client) BranchUpdate(project_id, name string, params *BranchParams
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // List all branches the of the current project.
 func (client *Client) BranchesList(project_id string, page, perPage int) ([]*Branch, error) {
 	retVal := []*Branch{}
+	ctx, span := client.startSpan("BranchesList", "project_id", project_id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s/branches", url.QueryEscape(project_id))
 
-		rc, err := client.sendRequestPaginated("GET", url, "", nil, 200, page, perPage)
+		rc, err := client.sendRequestPaginated(ctx, "GET", url, "", nil, 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Create a new comment for a key.
 func (client *Client) CommentCreate(project_id, key_id string, params *CommentParams) (*Comment, error) {
 	retVal := new(Comment)
+	ctx, span := client.startSpan("CommentCreate", "project_id", project_id, "key_id", key_id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/keys/%s/comments", url.QueryEscape(project_id), url.QueryEscape(key_id))
 
@@ -3118,23 +3114,18 @@ func (client *Client) CommentCreate(project_id, key_id string, params *CommentPa
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -3173,7 +3164,11 @@ func (params *CommentDeleteParams) QueryParams() map[string]string {
 // Delete an existing comment.
 func (client *Client) CommentDelete(project_id, key_id, id string, params *CommentDeleteParams) error {
 
+	ctx, span := client.startSpan("CommentDelete", "project_id", project_id, "key_id", key_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/keys/%s/comments/%s", url.QueryEscape(project_id), url.QueryEscape(key_id), url.QueryEscape(id))
 
@@ -3183,7 +3178,7 @@ func (client *Client) CommentDelete(project_id, key_id, id string, params *Comme
 			return err
 		}
 
-		rc, err := client.sendRequest("DELETE", url, "application/json", paramsBuf, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "application/json", paramsBuf, 204)
 
 		if err != nil {
 			return err
@@ -3192,6 +3187,8 @@ func (client *Client) CommentDelete(project_id, key_id, id string, params *Comme
 
 		return nil
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return err
 }
 
@@ -3230,11 +3227,15 @@ func (params *CommentMarkCheckParams) QueryParams() map[string]string {
 // Check if comment was marked as read. Returns 204 if read, 404 if unread.
 func (client *Client) CommentMarkCheck(project_id, key_id, id string, params *CommentMarkCheckParams) error {
 
+	ctx, span := client.startSpan("CommentMarkCheck", "project_id", project_id, "key_id", key_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/keys/%s/comments/%s/read", url.QueryEscape(project_id), url.QueryEscape(key_id), url.QueryEscape(id))
 
-		rc, err := client.sendGetRequest(url, params.QueryParams(), 204)
+		rc, err := client.sendGetRequest(ctx, url, params.QueryParams(), 204)
 
 		if err != nil {
 			return err
@@ -3243,6 +3244,8 @@ func (client *Client) CommentMarkCheck(project_id, key_id, id string, params *Co
 
 		return nil
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return err
 }
 
@@ -3281,7 +3284,11 @@ func (params *CommentMarkReadParams) QueryParams() map[string]string {
 // Mark a comment as read.
 func (client *Client) CommentMarkRead(project_id, key_id, id string, params *CommentMarkReadParams) error {
 
+	ctx, span := client.startSpan("CommentMarkRead", "project_id", project_id, "key_id", key_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/keys/%s/comments/%s/read", url.QueryEscape(project_id), url.QueryEscape(key_id), url.QueryEscape(id))
 
@@ -3291,7 +3298,7 @@ func (client *Client) CommentMarkRead(project_id, key_id, id string, params *Com
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 204)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 204)
 
 		if err != nil {
 			return err
@@ -3300,6 +3307,8 @@ func (client *Client) CommentMarkRead(project_id, key_id, id string, params *Com
 
 		return nil
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return err
 }
 
@@ -3338,7 +3347,11 @@ func (params *CommentMarkUnreadParams) QueryParams() map[string]string {
 // Mark a comment as unread.
 func (client *Client) CommentMarkUnread(project_id, key_id, id string, params *CommentMarkUnreadParams) error {
 
+	ctx, span := client.startSpan("CommentMarkUnread", "project_id", project_id, "key_id", key_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/keys/%s/comments/%s/read", url.QueryEscape(project_id), url.QueryEscape(key_id), url.QueryEscape(id))
 
@@ -3348,7 +3361,7 @@ func (client *Client) CommentMarkUnread(project_id, key_id, id string, params *C
 			return err
 		}
 
-		rc, err := client.sendRequest("DELETE", url, "application/json", paramsBuf, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "application/json", paramsBuf, 204)
 
 		if err != nil {
 			return err
@@ -3357,6 +3370,8 @@ func (client *Client) CommentMarkUnread(project_id, key_id, id string, params *C
 
 		return nil
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return err
 }
 
@@ -3395,34 +3410,37 @@ func (params *CommentShowParams) QueryParams() map[string]string {
 // Get details on a single comment.
 func (client *Client) CommentShow(project_id, key_id, id string, params *CommentShowParams) (*Comment, error) {
 	retVal := new(Comment)
+	ctx, span := client.startSpan("CommentShow", "project_id", project_id, "key_id", key_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/keys/%s/comments/%s", url.QueryEscape(project_id), url.QueryEscape(key_id), url.QueryEscape(id))
 
-		rc, err := client.sendGetRequest(url, params.QueryParams(), 200)
+		rc, err := client.sendGetRequest(ctx, url, params.QueryParams(), 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Update an existing comment.
 func (client *Client) CommentUpdate(project_id, key_id, id string, params *CommentParams) (*Comment, error) {
 	retVal := new(Comment)
+	ctx, span := client.startSpan("CommentUpdate", "project_id", project_id, "key_id", key_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/keys/%s/comments/%s", url.QueryEscape(project_id), url.QueryEscape(key_id), url.QueryEscape(id))
 
@@ -3432,23 +3450,18 @@ func (client *Client) CommentUpdate(project_id, key_id, id string, params *Comme
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -3487,34 +3500,37 @@ func (params *CommentsListParams) QueryParams() map[string]string {
 // List all comments for a key.
 func (client *Client) CommentsList(project_id, key_id string, page, perPage int, params *CommentsListParams) ([]*Comment, error) {
 	retVal := []*Comment{}
+	ctx, span := client.startSpan("CommentsList", "project_id", project_id, "key_id", key_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/keys/%s/comments", url.QueryEscape(project_id), url.QueryEscape(key_id))
 
-		rc, err := client.sendGetRequestPaginated(url, params.QueryParams(), 200, page, perPage)
+		rc, err := client.sendGetRequestPaginated(ctx, url, params.QueryParams(), 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Create a new distribution.
 func (client *Client) DistributionCreate(account_id string, params *DistributionsParams) (*Distribution, error) {
 	retVal := new(Distribution)
+	ctx, span := client.startSpan("DistributionCreate", "account_id", account_id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/accounts/%s/distributions", url.QueryEscape(account_id))
 
@@ -3524,34 +3540,30 @@ func (client *Client) DistributionCreate(account_id string, params *Distribution
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Delete an existing distribution.
 func (client *Client) DistributionDelete(account_id, id string) error {
 
+	ctx, span := client.startSpan("DistributionDelete", "account_id", account_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/distributions/%s", url.QueryEscape(account_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("DELETE", url, "", nil, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "", nil, 204)
 
 		if err != nil {
 			return err
@@ -3560,40 +3572,40 @@ func (client *Client) DistributionDelete(account_id, id string) error {
 
 		return nil
 	}()
+	endSpan(span, err)
 	return err
 }
 
 // Get details on a single distribution.
 func (client *Client) DistributionShow(account_id, id string) (*Distribution, error) {
 	retVal := new(Distribution)
+	ctx, span := client.startSpan("DistributionShow", "account_id", account_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/distributions/%s", url.QueryEscape(account_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("GET", url, "", nil, 200)
+		rc, err := client.sendRequest(ctx, "GET", url, "", nil, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Update an existing distribution.
 func (client *Client) DistributionUpdate(account_id, id string, params *DistributionsParams) (*Distribution, error) {
 	retVal := new(Distribution)
+	ctx, span := client.startSpan("DistributionUpdate", "account_id", account_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/accounts/%s/distributions/%s", url.QueryEscape(account_id), url.QueryEscape(id))
 
@@ -3603,111 +3615,95 @@ func (client *Client) DistributionUpdate(account_id, id string, params *Distribu
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // List all distributions for the given account.
 func (client *Client) DistributionsList(account_id string, page, perPage int) ([]*DistributionPreview, error) {
 	retVal := []*DistributionPreview{}
+	ctx, span := client.startSpan("DistributionsList", "account_id", account_id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/distributions", url.QueryEscape(account_id))
 
-		rc, err := client.sendRequestPaginated("GET", url, "", nil, 200, page, perPage)
+		rc, err := client.sendRequestPaginated(ctx, "GET", url, "", nil, 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Get a handy list of all localization file formats supported in Phrase.
 func (client *Client) FormatsList(page, perPage int) ([]*Format, error) {
 	retVal := []*Format{}
+	ctx, span := client.startSpan("FormatsList")
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/formats")
 
-		rc, err := client.sendRequestPaginated("GET", url, "", nil, 200, page, perPage)
+		rc, err := client.sendRequestPaginated(ctx, "GET", url, "", nil, 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // List all glossaries the current user has access to.
 func (client *Client) GlossariesList(account_id string, page, perPage int) ([]*Glossary, error) {
 	retVal := []*Glossary{}
+	ctx, span := client.startSpan("GlossariesList", "account_id", account_id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/glossaries", url.QueryEscape(account_id))
 
-		rc, err := client.sendRequestPaginated("GET", url, "", nil, 200, page, perPage)
+		rc, err := client.sendRequestPaginated(ctx, "GET", url, "", nil, 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Create a new glossary.
 func (client *Client) GlossaryCreate(account_id string, params *GlossaryParams) (*Glossary, error) {
 	retVal := new(Glossary)
+	ctx, span := client.startSpan("GlossaryCreate", "account_id", account_id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/accounts/%s/glossaries", url.QueryEscape(account_id))
 
@@ -3717,34 +3713,30 @@ func (client *Client) GlossaryCreate(account_id string, params *GlossaryParams)
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Delete an existing glossary.
 func (client *Client) GlossaryDelete(account_id, id string) error {
 
+	ctx, span := client.startSpan("GlossaryDelete", "account_id", account_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/glossaries/%s", url.QueryEscape(account_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("DELETE", url, "", nil, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "", nil, 204)
 
 		if err != nil {
 			return err
@@ -3753,40 +3745,40 @@ func (client *Client) GlossaryDelete(account_id, id string) error {
 
 		return nil
 	}()
+	endSpan(span, err)
 	return err
 }
 
 // Get details on a single glossary.
 func (client *Client) GlossaryShow(account_id, id string) (*Glossary, error) {
 	retVal := new(Glossary)
+	ctx, span := client.startSpan("GlossaryShow", "account_id", account_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/glossaries/%s", url.QueryEscape(account_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("GET", url, "", nil, 200)
+		rc, err := client.sendRequest(ctx, "GET", url, "", nil, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Update an existing glossary.
 func (client *Client) GlossaryUpdate(account_id, id string, params *GlossaryParams) (*Glossary, error) {
 	retVal := new(Glossary)
+	ctx, span := client.startSpan("GlossaryUpdate", "account_id", account_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/accounts/%s/glossaries/%s", url.QueryEscape(account_id), url.QueryEscape(id))
 
@@ -3796,30 +3788,29 @@ func (client *Client) GlossaryUpdate(account_id, id string, params *GlossaryPara
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Create a new glossary term.
 func (client *Client) GlossaryTermCreate(account_id, glossary_id string, params *GlossaryTermParams) (*GlossaryTerm, error) {
 	retVal := new(GlossaryTerm)
+	ctx, span := client.startSpan("GlossaryTermCreate", "account_id", account_id, "glossary_id", glossary_id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/accounts/%s/glossaries/%s/terms", url.QueryEscape(account_id), url.QueryEscape(glossary_id))
 
@@ -3829,34 +3820,30 @@ func (client *Client) GlossaryTermCreate(account_id, glossary_id string, params
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Delete an existing glossary term.
 func (client *Client) GlossaryTermDelete(account_id, glossary_id, id string) error {
 
+	ctx, span := client.startSpan("GlossaryTermDelete", "account_id", account_id, "glossary_id", glossary_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/glossaries/%s/terms/%s", url.QueryEscape(account_id), url.QueryEscape(glossary_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("DELETE", url, "", nil, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "", nil, 204)
 
 		if err != nil {
 			return err
@@ -3865,40 +3852,40 @@ func (client *Client) GlossaryTermDelete(account_id, glossary_id, id string) err
 
 		return nil
 	}()
+	endSpan(span, err)
 	return err
 }
 
 // Get details on a single glossary term.
 func (client *Client) GlossaryTermShow(account_id, glossary_id, id string) (*GlossaryTerm, error) {
 	retVal := new(GlossaryTerm)
+	ctx, span := client.startSpan("GlossaryTermShow", "account_id", account_id, "glossary_id", glossary_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/glossaries/%s/terms/%s", url.QueryEscape(account_id), url.QueryEscape(glossary_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("GET", url, "", nil, 200)
+		rc, err := client.sendRequest(ctx, "GET", url, "", nil, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Update an existing glossary term.
 func (client *Client) GlossaryTermUpdate(account_id, glossary_id, id string, params *GlossaryTermParams) (*GlossaryTerm, error) {
 	retVal := new(GlossaryTerm)
+	ctx, span := client.startSpan("GlossaryTermUpdate", "account_id", account_id, "glossary_id", glossary_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/accounts/%s/glossaries/%s/terms/%s", url.QueryEscape(account_id), url.QueryEscape(glossary_id), url.QueryEscape(id))
 
@@ -3908,30 +3895,29 @@ func (client *Client) GlossaryTermUpdate(account_id, glossary_id, id string, par
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Create a new glossary term translation.
 func (client *Client) GlossaryTermTranslationCreate(account_id, glossary_id, term_id string, params *GlossaryTermTranslationParams) (*GlossaryTermTranslation, error) {
 	retVal := new(GlossaryTermTranslation)
+	ctx, span := client.startSpan("GlossaryTermTranslationCreate", "account_id", account_id, "glossary_id", glossary_id, "term_id", term_id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/accounts/%s/glossaries/%s/terms/%s/translations", url.QueryEscape(account_id), url.QueryEscape(glossary_id), url.QueryEscape(term_id))
 
@@ -3941,34 +3927,30 @@ func (client *Client) GlossaryTermTranslationCreate(account_id, glossary_id, ter
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Delete an existing glossary term translation.
 func (client *Client) GlossaryTermTranslationDelete(account_id, glossary_id, term_id, id string) error {
 
+	ctx, span := client.startSpan("GlossaryTermTranslationDelete", "account_id", account_id, "glossary_id", glossary_id, "term_id", term_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/glossaries/%s/terms/%s/translations/%s", url.QueryEscape(account_id), url.QueryEscape(glossary_id), url.QueryEscape(term_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("DELETE", url, "", nil, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "", nil, 204)
 
 		if err != nil {
 			return err
@@ -3977,13 +3959,18 @@ func (client *Client) GlossaryTermTranslationDelete(account_id, glossary_id, ter
 
 		return nil
 	}()
+	endSpan(span, err)
 	return err
 }
 
 // Update an existing glossary term translation.
 func (client *Client) GlossaryTermTranslationUpdate(account_id, glossary_id, term_id, id string, params *GlossaryTermTranslationParams) (*GlossaryTermTranslation, error) {
 	retVal := new(GlossaryTermTranslation)
+	ctx, span := client.startSpan("GlossaryTermTranslationUpdate", "account_id", account_id, "glossary_id", glossary_id, "term_id", term_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/accounts/%s/glossaries/%s/terms/%s/translations/%s", url.QueryEscape(account_id), url.QueryEscape(glossary_id), url.QueryEscape(term_id), url.QueryEscape(id))
 
@@ -3993,50 +3980,40 @@ func (client *Client) GlossaryTermTranslationUpdate(account_id, glossary_id, ter
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // List all glossary terms the current user has access to.
 func (client *Client) GlossaryTermsList(account_id, glossary_id string, page, perPage int) ([]*GlossaryTerm, error) {
 	retVal := []*GlossaryTerm{}
+	ctx, span := client.startSpan("GlossaryTermsList", "account_id", account_id, "glossary_id", glossary_id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/glossaries/%s/terms", url.QueryEscape(account_id), url.QueryEscape(glossary_id))
 
-		rc, err := client.sendRequestPaginated("GET", url, "", nil, 200, page, perPage)
+		rc, err := client.sendRequestPaginated(ctx, "GET", url, "", nil, 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -4127,7 +4104,11 @@ func (params *InvitationCreateParams) QueryParams() map[string]string {
 // Invite a person to an account. Developers and translators need <code>project_ids</code> and <code>locale_ids</code> assigned to access them. Access token scope must include <code>team.manage</code>.
 func (client *Client) InvitationCreate(account_id string, params *InvitationCreateParams) (*Invitation, error) {
 	retVal := new(Invitation)
+	ctx, span := client.startSpan("InvitationCreate", "account_id", account_id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/accounts/%s/invitations", url.QueryEscape(account_id))
 
@@ -4137,34 +4118,30 @@ func (client *Client) InvitationCreate(account_id string, params *InvitationCrea
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Delete an existing invitation (must not be accepted yet). Access token scope must include <code>team.manage</code>.
 func (client *Client) InvitationDelete(account_id, id string) error {
 
+	ctx, span := client.startSpan("InvitationDelete", "account_id", account_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/invitations/%s", url.QueryEscape(account_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("DELETE", url, "", nil, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "", nil, 204)
 
 		if err != nil {
 			return err
@@ -4173,60 +4150,51 @@ func (client *Client) InvitationDelete(account_id, id string) error {
 
 		return nil
 	}()
+	endSpan(span, err)
 	return err
 }
 
 // Resend the invitation email (must not be accepted yet). Access token scope must include <code>team.manage</code>.
 func (client *Client) InvitationResend(account_id, id string) (*Invitation, error) {
 	retVal := new(Invitation)
+	ctx, span := client.startSpan("InvitationResend", "account_id", account_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/invitations/%s/resend", url.QueryEscape(account_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("POST", url, "", nil, 200)
+		rc, err := client.sendRequest(ctx, "POST", url, "", nil, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Get details on a single invitation. Access token scope must include <code>team.manage</code>.
 func (client *Client) InvitationShow(account_id, id string) (*Invitation, error) {
 	retVal := new(Invitation)
+	ctx, span := client.startSpan("InvitationShow", "account_id", account_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/invitations/%s", url.QueryEscape(account_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("GET", url, "", nil, 200)
+		rc, err := client.sendRequest(ctx, "GET", url, "", nil, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -4305,7 +4273,11 @@ func (params *InvitationUpdateParams) QueryParams() map[string]string {
 // Update an existing invitation (must not be accepted yet). The <code>email</code> cannot be updated. Developers and translators need <code>project_ids</code> and <code>locale_ids</code> assigned to access them. Access token scope must include <code>team.manage</code>.
 func (client *Client) InvitationUpdate(account_id, id string, params *InvitationUpdateParams) (*Invitation, error) {
 	retVal := new(Invitation)
+	ctx, span := client.startSpan("InvitationUpdate", "account_id", account_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/accounts/%s/invitations/%s", url.QueryEscape(account_id), url.QueryEscape(id))
 
@@ -4315,50 +4287,40 @@ func (client *Client) InvitationUpdate(account_id, id string, params *Invitation
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // List invitations for an account. It will also list the accessible resources like projects and locales the invited user has access to. In case nothing is shown the default access from the role is used. Access token scope must include <code>team.manage</code>.
 func (client *Client) InvitationsList(account_id string, page, perPage int) ([]*Invitation, error) {
 	retVal := []*Invitation{}
+	ctx, span := client.startSpan("InvitationsList", "account_id", account_id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/invitations", url.QueryEscape(account_id))
 
-		rc, err := client.sendRequestPaginated("GET", url, "", nil, 200, page, perPage)
+		rc, err := client.sendRequestPaginated(ctx, "GET", url, "", nil, 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -4397,7 +4359,11 @@ func (params *JobCompleteParams) QueryParams() map[string]string {
 // Mark a job as completed.
 func (client *Client) JobComplete(project_id, id string, params *JobCompleteParams) (*JobDetails, error) {
 	retVal := new(JobDetails)
+	ctx, span := client.startSpan("JobComplete", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/jobs/%s/complete", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -4407,30 +4373,29 @@ func (client *Client) JobComplete(project_id, id string, params *JobCompletePara
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Create a new job.
 func (client *Client) JobCreate(project_id string, params *JobParams) (*JobDetails, error) {
 	retVal := new(JobDetails)
+	ctx, span := client.startSpan("JobCreate", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/jobs", url.QueryEscape(project_id))
 
@@ -4440,23 +4405,18 @@ func (client *Client) JobCreate(project_id string, params *JobParams) (*JobDetai
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -4495,7 +4455,11 @@ func (params *JobDeleteParams) QueryParams() map[string]string {
 // Delete an existing job.
 func (client *Client) JobDelete(project_id, id string, params *JobDeleteParams) error {
 
+	ctx, span := client.startSpan("JobDelete", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/jobs/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -4505,7 +4469,7 @@ func (client *Client) JobDelete(project_id, id string, params *JobDeleteParams)
 			return err
 		}
 
-		rc, err := client.sendRequest("DELETE", url, "application/json", paramsBuf, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "application/json", paramsBuf, 204)
 
 		if err != nil {
 			return err
@@ -4514,6 +4478,8 @@ func (client *Client) JobDelete(project_id, id string, params *JobDeleteParams)
 
 		return nil
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return err
 }
 
@@ -4559,7 +4525,11 @@ func (params *JobKeysCreateParams) QueryParams() map[string]string {
 // Add multiple keys to a existing job.
 func (client *Client) JobKeysCreate(project_id, id string, params *JobKeysCreateParams) (*JobDetails, error) {
 	retVal := new(JobDetails)
+	ctx, span := client.startSpan("JobKeysCreate", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/jobs/%s/keys", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -4569,23 +4539,18 @@ func (client *Client) JobKeysCreate(project_id, id string, params *JobKeysCreate
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -4631,7 +4596,11 @@ func (params *JobKeysDeleteParams) QueryParams() map[string]string {
 // Remove multiple keys from existing job.
 func (client *Client) JobKeysDelete(project_id, id string, params *JobKeysDeleteParams) error {
 
+	ctx, span := client.startSpan("JobKeysDelete", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/jobs/%s/keys", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -4641,7 +4610,7 @@ func (client *Client) JobKeysDelete(project_id, id string, params *JobKeysDelete
 			return err
 		}
 
-		rc, err := client.sendRequest("DELETE", url, "application/json", paramsBuf, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "application/json", paramsBuf, 204)
 
 		if err != nil {
 			return err
@@ -4650,6 +4619,8 @@ func (client *Client) JobKeysDelete(project_id, id string, params *JobKeysDelete
 
 		return nil
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return err
 }
 
@@ -4688,7 +4659,11 @@ func (params *JobReopenParams) QueryParams() map[string]string {
 // Mark a job as uncompleted.
 func (client *Client) JobReopen(project_id, id string, params *JobReopenParams) (*JobDetails, error) {
 	retVal := new(JobDetails)
+	ctx, span := client.startSpan("JobReopen", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/jobs/%s/reopen", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -4698,23 +4673,18 @@ func (client *Client) JobReopen(project_id, id string, params *JobReopenParams)
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -4753,27 +4723,26 @@ func (params *JobShowParams) QueryParams() map[string]string {
 // Get details on a single job for a given project.
 func (client *Client) JobShow(project_id, id string, params *JobShowParams) (*JobDetails, error) {
 	retVal := new(JobDetails)
+	ctx, span := client.startSpan("JobShow", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/jobs/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
-		rc, err := client.sendGetRequest(url, params.QueryParams(), 200)
+		rc, err := client.sendGetRequest(ctx, url, params.QueryParams(), 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -4812,7 +4781,11 @@ func (params *JobStartParams) QueryParams() map[string]string {
 // Starts an existing job in state draft.
 func (client *Client) JobStart(project_id, id string, params *JobStartParams) (*JobDetails, error) {
 	retVal := new(JobDetails)
+	ctx, span := client.startSpan("JobStart", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/jobs/%s/start", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -4822,23 +4795,18 @@ func (client *Client) JobStart(project_id, id string, params *JobStartParams) (*
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -4909,7 +4877,11 @@ func (params *JobUpdateParams) QueryParams() map[string]string {
 // Update an existing job.
 func (client *Client) JobUpdate(project_id, id string, params *JobUpdateParams) (*JobDetails, error) {
 	retVal := new(JobDetails)
+	ctx, span := client.startSpan("JobUpdate", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/jobs/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -4919,23 +4891,18 @@ func (client *Client) JobUpdate(project_id, id string, params *JobUpdateParams)
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -4974,7 +4941,11 @@ func (params *JobLocaleCompleteParams) QueryParams() map[string]string {
 // Mark a job locale as completed.
 func (client *Client) JobLocaleComplete(project_id, job_id, id string, params *JobLocaleCompleteParams) (*JobLocale, error) {
 	retVal := new(JobLocale)
+	ctx, span := client.startSpan("JobLocaleComplete", "project_id", project_id, "job_id", job_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/jobs/%s/locales/%s/complete", url.QueryEscape(project_id), url.QueryEscape(job_id), url.QueryEscape(id))
 
@@ -4984,23 +4955,18 @@ func (client *Client) JobLocaleComplete(project_id, job_id, id string, params *J
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -5039,7 +5005,11 @@ func (params *JobLocaleDeleteParams) QueryParams() map[string]string {
 // Delete an existing job locale.
 func (client *Client) JobLocaleDelete(project_id, job_id, id string, params *JobLocaleDeleteParams) error {
 
+	ctx, span := client.startSpan("JobLocaleDelete", "project_id", project_id, "job_id", job_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/jobs/%s/locales/%s", url.QueryEscape(project_id), url.QueryEscape(job_id), url.QueryEscape(id))
 
@@ -5049,7 +5019,7 @@ func (client *Client) JobLocaleDelete(project_id, job_id, id string, params *Job
 			return err
 		}
 
-		rc, err := client.sendRequest("DELETE", url, "application/json", paramsBuf, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "application/json", paramsBuf, 204)
 
 		if err != nil {
 			return err
@@ -5058,6 +5028,8 @@ func (client *Client) JobLocaleDelete(project_id, job_id, id string, params *Job
 
 		return nil
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return err
 }
 
@@ -5096,7 +5068,11 @@ func (params *JobLocaleReopenParams) QueryParams() map[string]string {
 // Mark a job locale as uncompleted.
 func (client *Client) JobLocaleReopen(project_id, job_id, id string, params *JobLocaleReopenParams) (*JobLocale, error) {
 	retVal := new(JobLocale)
+	ctx, span := client.startSpan("JobLocaleReopen", "project_id", project_id, "job_id", job_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/jobs/%s/locales/%s/reopen", url.QueryEscape(project_id), url.QueryEscape(job_id), url.QueryEscape(id))
 
@@ -5106,23 +5082,18 @@ func (client *Client) JobLocaleReopen(project_id, job_id, id string, params *Job
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -5161,34 +5132,37 @@ func (params *JobLocaleShowParams) QueryParams() map[string]string {
 // Get a single job locale for a given job.
 func (client *Client) JobLocaleShow(project_id, job_id, id string, params *JobLocaleShowParams) (*JobLocale, error) {
 	retVal := new(JobLocale)
+	ctx, span := client.startSpan("JobLocaleShow", "project_id", project_id, "job_id", job_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/jobs/%s/locale/%s", url.QueryEscape(project_id), url.QueryEscape(job_id), url.QueryEscape(id))
 
-		rc, err := client.sendGetRequest(url, params.QueryParams(), 200)
+		rc, err := client.sendGetRequest(ctx, url, params.QueryParams(), 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Update an existing job locale.
 func (client *Client) JobLocaleUpdate(project_id, job_id, id string, params *JobLocaleParams) (*JobLocale, error) {
 	retVal := new(JobLocale)
+	ctx, span := client.startSpan("JobLocaleUpdate", "project_id", project_id, "job_id", job_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/jobs/%s/locales/%s", url.QueryEscape(project_id), url.QueryEscape(job_id), url.QueryEscape(id))
 
@@ -5198,30 +5172,29 @@ func (client *Client) JobLocaleUpdate(project_id, job_id, id string, params *Job
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Create a new job locale.
 func (client *Client) JobLocalesCreate(project_id, job_id string, params *JobLocaleParams) (*JobLocale, error) {
 	retVal := new(JobLocale)
+	ctx, span := client.startSpan("JobLocalesCreate", "project_id", project_id, "job_id", job_id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/jobs/%s/locales", url.QueryEscape(project_id), url.QueryEscape(job_id))
 
@@ -5231,23 +5204,18 @@ func (client *Client) JobLocalesCreate(project_id, job_id string, params *JobLoc
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -5286,27 +5254,26 @@ func (params *JobLocalesListParams) QueryParams() map[string]string {
 // List all job locales for a given job.
 func (client *Client) JobLocalesList(project_id, job_id string, page, perPage int, params *JobLocalesListParams) ([]*JobLocale, error) {
 	retVal := []*JobLocale{}
+	ctx, span := client.startSpan("JobLocalesList", "project_id", project_id, "job_id", job_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/jobs/%s/locales", url.QueryEscape(project_id), url.QueryEscape(job_id))
 
-		rc, err := client.sendGetRequestPaginated(url, params.QueryParams(), 200, page, perPage)
+		rc, err := client.sendGetRequestPaginated(ctx, url, params.QueryParams(), 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -5381,37 +5348,46 @@ func (params *JobsListParams) QueryParams() map[string]string {
 // List all jobs for the given project.
 func (client *Client) JobsList(project_id string, page, perPage int, params *JobsListParams) ([]*Job, error) {
 	retVal := []*Job{}
+	ctx, span := client.startSpan("JobsList", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/jobs", url.QueryEscape(project_id))
 
-		rc, err := client.sendGetRequestPaginated(url, params.QueryParams(), 200, page, perPage)
+		rc, err := client.sendGetRequestPaginated(ctx, url, params.QueryParams(), 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Create a new key.
 func (client *Client) KeyCreate(project_id string, params *TranslationKeyParams) (*TranslationKeyDetails, error) {
 	retVal := new(TranslationKeyDetails)
-	err := func() error {
+	ctx, span := client.startSpan("KeyCreate", "project_id", project_id)
+	err := func() (err error) {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/keys", url.QueryEscape(project_id))
 
+		_, buildSpan := startChildSpan(ctx, "multipart")
+		defer func() {
+			if buildSpan != nil {
+				endSpan(buildSpan, err)
+			}
+		}()
 		paramsBuf := bytes.NewBuffer(nil)
 		writer := multipart.NewWriter(paramsBuf)
 		ctype := writer.FormDataContentType()
@@ -5532,26 +5508,23 @@ func (client *Client) KeyCreate(project_id string, params *TranslationKeyParams)
 				return err
 			}
 		}
-		err := writer.WriteField("utf8", "✓")
+		err = writer.WriteField("utf8", "✓")
 		writer.Close()
+		buildSpan.End()
+		buildSpan = nil
 
-		rc, err := client.sendRequest("POST", url, ctype, paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, ctype, paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -5590,7 +5563,11 @@ func (params *KeyDeleteParams) QueryParams() map[string]string {
 // Delete an existing key.
 func (client *Client) KeyDelete(project_id, id string, params *KeyDeleteParams) error {
 
+	ctx, span := client.startSpan("KeyDelete", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/keys/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -5600,7 +5577,7 @@ func (client *Client) KeyDelete(project_id, id string, params *KeyDeleteParams)
 			return err
 		}
 
-		rc, err := client.sendRequest("DELETE", url, "application/json", paramsBuf, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "application/json", paramsBuf, 204)
 
 		if err != nil {
 			return err
@@ -5609,6 +5586,8 @@ func (client *Client) KeyDelete(project_id, id string, params *KeyDeleteParams)
 
 		return nil
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return err
 }
 
@@ -5647,37 +5626,46 @@ func (params *KeyShowParams) QueryParams() map[string]string {
 // Get details on a single key for a given project.
 func (client *Client) KeyShow(project_id, id string, params *KeyShowParams) (*TranslationKeyDetails, error) {
 	retVal := new(TranslationKeyDetails)
+	ctx, span := client.startSpan("KeyShow", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/keys/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
-		rc, err := client.sendGetRequest(url, params.QueryParams(), 200)
+		rc, err := client.sendGetRequest(ctx, url, params.QueryParams(), 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Update an existing key.
 func (client *Client) KeyUpdate(project_id, id string, params *TranslationKeyParams) (*TranslationKeyDetails, error) {
 	retVal := new(TranslationKeyDetails)
-	err := func() error {
+	ctx, span := client.startSpan("KeyUpdate", "project_id", project_id, "id", id)
+	err := func() (err error) {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/keys/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
+		_, buildSpan := startChildSpan(ctx, "multipart")
+		defer func() {
+			if buildSpan != nil {
+				endSpan(buildSpan, err)
+			}
+		}()
 		paramsBuf := bytes.NewBuffer(nil)
 		writer := multipart.NewWriter(paramsBuf)
 		ctype := writer.FormDataContentType()
@@ -5798,26 +5786,23 @@ func (client *Client) KeyUpdate(project_id, id string, params *TranslationKeyPar
 				return err
 			}
 		}
-		err := writer.WriteField("utf8", "✓")
+		err = writer.WriteField("utf8", "✓")
 		writer.Close()
+		buildSpan.End()
+		buildSpan = nil
 
-		rc, err := client.sendRequest("PATCH", url, ctype, paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, ctype, paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -5876,7 +5861,11 @@ func (params *KeysDeleteParams) QueryParams() map[string]string {
 // Delete all keys matching query. Same constraints as list. Please limit the number of affected keys to about 1,000 as you might experience timeouts otherwise.
 func (client *Client) KeysDelete(project_id string, params *KeysDeleteParams) (*AffectedResources, error) {
 	retVal := new(AffectedResources)
+	ctx, span := client.startSpan("KeysDelete", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/keys", url.QueryEscape(project_id))
 
@@ -5886,23 +5875,18 @@ func (client *Client) KeysDelete(project_id string, params *KeysDeleteParams) (*
 			return err
 		}
 
-		rc, err := client.sendRequest("DELETE", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -5985,27 +5969,26 @@ func (params *KeysListParams) QueryParams() map[string]string {
 // List all keys for the given project. Alternatively you can POST requests to /search.
 func (client *Client) KeysList(project_id string, page, perPage int, params *KeysListParams) ([]*TranslationKey, error) {
 	retVal := []*TranslationKey{}
+	ctx, span := client.startSpan("KeysList", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/keys", url.QueryEscape(project_id))
 
-		rc, err := client.sendGetRequestPaginated(url, params.QueryParams(), 200, page, perPage)
+		rc, err := client.sendGetRequestPaginated(ctx, url, params.QueryParams(), 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -6088,7 +6071,11 @@ func (params *KeysSearchParams) QueryParams() map[string]string {
 // Search keys for the given project matching query.
 func (client *Client) KeysSearch(project_id string, page, perPage int, params *KeysSearchParams) ([]*TranslationKey, error) {
 	retVal := []*TranslationKey{}
+	ctx, span := client.startSpan("KeysSearch", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/keys/search", url.QueryEscape(project_id))
 
@@ -6098,23 +6085,18 @@ func (client *Client) KeysSearch(project_id string, page, perPage int, params *K
 			return err
 		}
 
-		rc, err := client.sendRequestPaginated("POST", url, "application/json", paramsBuf, 200, page, perPage)
+		rc, err := client.sendRequestPaginated(ctx, "POST", url, "application/json", paramsBuf, 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -6185,7 +6167,11 @@ func (params *KeysTagParams) QueryParams() map[string]string {
 // Tags all keys matching query. Same constraints as list.
 func (client *Client) KeysTag(project_id string, params *KeysTagParams) (*AffectedResources, error) {
 	retVal := new(AffectedResources)
+	ctx, span := client.startSpan("KeysTag", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/keys/tag", url.QueryEscape(project_id))
 
@@ -6195,23 +6181,18 @@ func (client *Client) KeysTag(project_id string, params *KeysTagParams) (*Affect
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -6282,7 +6263,11 @@ func (params *KeysUntagParams) QueryParams() map[string]string {
 // Removes specified tags from keys matching query.
 func (client *Client) KeysUntag(project_id string, params *KeysUntagParams) (*AffectedResources, error) {
 	retVal := new(AffectedResources)
+	ctx, span := client.startSpan("KeysUntag", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/keys/untag", url.QueryEscape(project_id))
 
@@ -6292,30 +6277,29 @@ func (client *Client) KeysUntag(project_id string, params *KeysUntagParams) (*Af
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Create a new locale.
 func (client *Client) LocaleCreate(project_id string, params *LocaleParams) (*LocaleDetails, error) {
 	retVal := new(LocaleDetails)
+	ctx, span := client.startSpan("LocaleCreate", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/locales", url.QueryEscape(project_id))
 
@@ -6325,23 +6309,18 @@ func (client *Client) LocaleCreate(project_id string, params *LocaleParams) (*Lo
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -6380,7 +6359,11 @@ func (params *LocaleDeleteParams) QueryParams() map[string]string {
 // Delete an existing locale.
 func (client *Client) LocaleDelete(project_id, id string, params *LocaleDeleteParams) error {
 
+	ctx, span := client.startSpan("LocaleDelete", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/locales/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -6390,7 +6373,7 @@ func (client *Client) LocaleDelete(project_id, id string, params *LocaleDeletePa
 			return err
 		}
 
-		rc, err := client.sendRequest("DELETE", url, "application/json", paramsBuf, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "application/json", paramsBuf, 204)
 
 		if err != nil {
 			return err
@@ -6399,6 +6382,8 @@ func (client *Client) LocaleDelete(project_id, id string, params *LocaleDeletePa
 
 		return nil
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return err
 }
 
@@ -6407,7 +6392,7 @@ type LocaleDownloadParams struct {
 	ConvertEmoji                  *bool             `json:"convert_emoji,omitempty"  cli:"opt --convert-emoji"`
 	Encoding                      *string           `json:"encoding,omitempty"  cli:"opt --encoding"`
 	FallbackLocaleID              *string           `json:"fallback_locale_id,omitempty"  cli:"opt --fallback-locale-id"`
-	FileFormat                    *string           `json:"file_format,omitempty"  cli:"opt --file-format"`
+	FileFormat                    *FileFormat       `json:"file_format,omitempty"  cli:"opt --file-format"`
 	FormatOptions                 map[string]string `json:"format_options,omitempty"  cli:"opt --format-options"`
 	IncludeEmptyTranslations      *bool             `json:"include_empty_translations,omitempty"  cli:"opt --include-empty-translations"`
 	IncludeTranslatedKeys         *bool             `json:"include_translated_keys,omitempty"  cli:"opt --include-translated-keys"`
@@ -6455,7 +6440,8 @@ func (params *LocaleDownloadParams) ApplyValuesFromMap(defaults map[string]inter
 			if !ok {
 				return fmt.Errorf(cfgValueErrStr, k, v)
 			}
-			params.FileFormat = &val
+			format := FileFormat(val)
+			params.FileFormat = &format
 
 		case "format_options":
 			rval, err := ValidateIsRawMap(k, v)
@@ -6552,7 +6538,7 @@ func (params *LocaleDownloadParams) QueryParams() map[string]string {
 	}
 
 	if params.FileFormat != nil && *params.FileFormat != "" {
-		queryParams["file_format"] = *params.FileFormat
+		queryParams["file_format"] = string(*params.FileFormat)
 	}
 
 	for key, value := range convertMapToQueryParams("format_options", params.FormatOptions) {
@@ -6597,28 +6583,27 @@ func (params *LocaleDownloadParams) QueryParams() map[string]string {
 // Download a locale in a specific file format.
 func (client *Client) LocaleDownload(project_id, id string, params *LocaleDownloadParams) ([]byte, error) {
 	retVal := []byte{}
+	ctx, span := client.startSpan("LocaleDownload", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/locales/%s/download", url.QueryEscape(project_id), url.QueryEscape(id))
 
-		rc, err := client.sendGetRequest(url, params.QueryParams(), 200)
+		rc, err := client.sendGetRequest(ctx, url, params.QueryParams(), 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		retVal, err = ioutil.ReadAll(reader)
+		retVal, err = readBody(ctx, rc)
 		return err
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -6657,34 +6642,37 @@ func (params *LocaleShowParams) QueryParams() map[string]string {
 // Get details on a single locale for a given project.
 func (client *Client) LocaleShow(project_id, id string, params *LocaleShowParams) (*LocaleDetails, error) {
 	retVal := new(LocaleDetails)
+	ctx, span := client.startSpan("LocaleShow", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/locales/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
-		rc, err := client.sendGetRequest(url, params.QueryParams(), 200)
+		rc, err := client.sendGetRequest(ctx, url, params.QueryParams(), 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Update an existing locale.
 func (client *Client) LocaleUpdate(project_id, id string, params *LocaleParams) (*LocaleDetails, error) {
 	retVal := new(LocaleDetails)
+	ctx, span := client.startSpan("LocaleUpdate", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/locales/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -6694,23 +6682,18 @@ func (client *Client) LocaleUpdate(project_id, id string, params *LocaleParams)
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -6749,38 +6732,38 @@ func (params *LocalesListParams) QueryParams() map[string]string {
 // List all locales for the given project.
 func (client *Client) LocalesList(project_id string, page, perPage int, params *LocalesListParams) ([]*Locale, error) {
 	retVal := []*Locale{}
+	ctx, span := client.startSpan("LocalesList", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/locales", url.QueryEscape(project_id))
 
-		rc, err := client.sendGetRequestPaginated(url, params.QueryParams(), 200, page, perPage)
+		rc, err := client.sendGetRequestPaginated(ctx, url, params.QueryParams(), 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Remove a user from the account. The user will be removed from the account but not deleted from Phrase. Access token scope must include <code>team.manage</code>.
 func (client *Client) MemberDelete(account_id, id string) error {
 
+	ctx, span := client.startSpan("MemberDelete", "account_id", account_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/members/%s", url.QueryEscape(account_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("DELETE", url, "", nil, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "", nil, 204)
 
 		if err != nil {
 			return err
@@ -6789,33 +6772,29 @@ func (client *Client) MemberDelete(account_id, id string) error {
 
 		return nil
 	}()
+	endSpan(span, err)
 	return err
 }
 
 // Get details on a single user in the account. Access token scope must include <code>team.manage</code>.
 func (client *Client) MemberShow(account_id, id string) (*Member, error) {
 	retVal := new(Member)
+	ctx, span := client.startSpan("MemberShow", "account_id", account_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/members/%s", url.QueryEscape(account_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("GET", url, "", nil, 200)
+		rc, err := client.sendRequest(ctx, "GET", url, "", nil, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -6894,7 +6873,11 @@ func (params *MemberUpdateParams) QueryParams() map[string]string {
 // Update user permissions in the account. Developers and translators need <code>project_ids</code> and <code>locale_ids</code> assigned to access them. Access token scope must include <code>team.manage</code>.
 func (client *Client) MemberUpdate(account_id, id string, params *MemberUpdateParams) (*Member, error) {
 	retVal := new(Member)
+	ctx, span := client.startSpan("MemberUpdate", "account_id", account_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/accounts/%s/members/%s", url.QueryEscape(account_id), url.QueryEscape(id))
 
@@ -6904,50 +6887,40 @@ func (client *Client) MemberUpdate(account_id, id string, params *MemberUpdatePa
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Get all users active in the account. It also lists resources like projects and locales the member has access to. In case nothing is shown the default access from the role is used. Access token scope must include <code>team.manage</code>.
 func (client *Client) MembersList(account_id string, page, perPage int) ([]*Member, error) {
 	retVal := []*Member{}
+	ctx, span := client.startSpan("MembersList", "account_id", account_id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/members", url.QueryEscape(account_id))
 
-		rc, err := client.sendRequestPaginated("GET", url, "", nil, 200, page, perPage)
+		rc, err := client.sendRequestPaginated(ctx, "GET", url, "", nil, 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -6986,7 +6959,11 @@ func (params *OrderConfirmParams) QueryParams() map[string]string {
 // Confirm an existing order and send it to the provider for translation. Same constraints as for create.
 func (client *Client) OrderConfirm(project_id, id string, params *OrderConfirmParams) (*TranslationOrder, error) {
 	retVal := new(TranslationOrder)
+	ctx, span := client.startSpan("OrderConfirm", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/orders/%s/confirm", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -6996,30 +6973,29 @@ func (client *Client) OrderConfirm(project_id, id string, params *OrderConfirmPa
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Create a new order. Access token scope must include <code>orders.create</code>.
 func (client *Client) OrderCreate(project_id string, params *TranslationOrderParams) (*TranslationOrder, error) {
 	retVal := new(TranslationOrder)
+	ctx, span := client.startSpan("OrderCreate", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/orders", url.QueryEscape(project_id))
 
@@ -7029,23 +7005,18 @@ func (client *Client) OrderCreate(project_id string, params *TranslationOrderPar
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -7084,7 +7055,11 @@ func (params *OrderDeleteParams) QueryParams() map[string]string {
 // Cancel an existing order. Must not yet be confirmed.
 func (client *Client) OrderDelete(project_id, id string, params *OrderDeleteParams) error {
 
+	ctx, span := client.startSpan("OrderDelete", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/orders/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -7094,7 +7069,7 @@ func (client *Client) OrderDelete(project_id, id string, params *OrderDeletePara
 			return err
 		}
 
-		rc, err := client.sendRequest("DELETE", url, "application/json", paramsBuf, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "application/json", paramsBuf, 204)
 
 		if err != nil {
 			return err
@@ -7103,6 +7078,8 @@ func (client *Client) OrderDelete(project_id, id string, params *OrderDeletePara
 
 		return nil
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return err
 }
 
@@ -7141,27 +7118,26 @@ func (params *OrderShowParams) QueryParams() map[string]string {
 // Get details on a single order.
 func (client *Client) OrderShow(project_id, id string, params *OrderShowParams) (*TranslationOrder, error) {
 	retVal := new(TranslationOrder)
+	ctx, span := client.startSpan("OrderShow", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/orders/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
-		rc, err := client.sendGetRequest(url, params.QueryParams(), 200)
+		rc, err := client.sendGetRequest(ctx, url, params.QueryParams(), 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -7200,37 +7176,46 @@ func (params *OrdersListParams) QueryParams() map[string]string {
 // List all orders for the given project.
 func (client *Client) OrdersList(project_id string, page, perPage int, params *OrdersListParams) ([]*TranslationOrder, error) {
 	retVal := []*TranslationOrder{}
+	ctx, span := client.startSpan("OrdersList", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/orders", url.QueryEscape(project_id))
 
-		rc, err := client.sendGetRequestPaginated(url, params.QueryParams(), 200, page, perPage)
+		rc, err := client.sendGetRequestPaginated(ctx, url, params.QueryParams(), 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Create a new project.
 func (client *Client) ProjectCreate(params *ProjectParams) (*ProjectDetails, error) {
 	retVal := new(ProjectDetails)
-	err := func() error {
+	ctx, span := client.startSpan("ProjectCreate")
+	err := func() (err error) {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects")
 
+		_, buildSpan := startChildSpan(ctx, "multipart")
+		defer func() {
+			if buildSpan != nil {
+				endSpan(buildSpan, err)
+			}
+		}()
 		paramsBuf := bytes.NewBuffer(nil)
 		writer := multipart.NewWriter(paramsBuf)
 		ctype := writer.FormDataContentType()
@@ -7288,37 +7273,35 @@ func (client *Client) ProjectCreate(params *ProjectParams) (*ProjectDetails, err
 				return err
 			}
 		}
-		err := writer.WriteField("utf8", "✓")
+		err = writer.WriteField("utf8", "✓")
 		writer.Close()
+		buildSpan.End()
+		buildSpan = nil
 
-		rc, err := client.sendRequest("POST", url, ctype, paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, ctype, paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Delete an existing project.
 func (client *Client) ProjectDelete(id string) error {
 
+	ctx, span := client.startSpan("ProjectDelete", "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s", url.QueryEscape(id))
 
-		rc, err := client.sendRequest("DELETE", url, "", nil, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "", nil, 204)
 
 		if err != nil {
 			return err
@@ -7327,43 +7310,49 @@ func (client *Client) ProjectDelete(id string) error {
 
 		return nil
 	}()
+	endSpan(span, err)
 	return err
 }
 
 // Get details on a single project.
 func (client *Client) ProjectShow(id string) (*ProjectDetails, error) {
 	retVal := new(ProjectDetails)
+	ctx, span := client.startSpan("ProjectShow", "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s", url.QueryEscape(id))
 
-		rc, err := client.sendRequest("GET", url, "", nil, 200)
+		rc, err := client.sendRequest(ctx, "GET", url, "", nil, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Update an existing project.
 func (client *Client) ProjectUpdate(id string, params *ProjectParams) (*ProjectDetails, error) {
 	retVal := new(ProjectDetails)
-	err := func() error {
+	ctx, span := client.startSpan("ProjectUpdate", "id", id)
+	err := func() (err error) {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s", url.QueryEscape(id))
 
+		_, buildSpan := startChildSpan(ctx, "multipart")
+		defer func() {
+			if buildSpan != nil {
+				endSpan(buildSpan, err)
+			}
+		}()
 		paramsBuf := bytes.NewBuffer(nil)
 		writer := multipart.NewWriter(paramsBuf)
 		ctype := writer.FormDataContentType()
@@ -7421,60 +7410,56 @@ func (client *Client) ProjectUpdate(id string, params *ProjectParams) (*ProjectD
 				return err
 			}
 		}
-		err := writer.WriteField("utf8", "✓")
+		err = writer.WriteField("utf8", "✓")
 		writer.Close()
+		buildSpan.End()
+		buildSpan = nil
 
-		rc, err := client.sendRequest("PATCH", url, ctype, paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, ctype, paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // List all projects the current user has access to.
 func (client *Client) ProjectsList(page, perPage int) ([]*Project, error) {
 	retVal := []*Project{}
+	ctx, span := client.startSpan("ProjectsList")
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects")
 
-		rc, err := client.sendRequestPaginated("GET", url, "", nil, 200, page, perPage)
+		rc, err := client.sendRequestPaginated(ctx, "GET", url, "", nil, 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Create a new release.
 func (client *Client) ReleaseCreate(account_id, distribution_id string, params *ReleasesParams) (*Release, error) {
 	retVal := new(Release)
+	ctx, span := client.startSpan("ReleaseCreate", "account_id", account_id, "distribution_id", distribution_id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/accounts/%s/distributions/%s/releases", url.QueryEscape(account_id), url.QueryEscape(distribution_id))
 
@@ -7484,34 +7469,30 @@ func (client *Client) ReleaseCreate(account_id, distribution_id string, params *
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Delete an existing release.
 func (client *Client) ReleaseDelete(account_id, distribution_id, id string) error {
 
+	ctx, span := client.startSpan("ReleaseDelete", "account_id", account_id, "distribution_id", distribution_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/distributions/%s/releases/%s", url.QueryEscape(account_id), url.QueryEscape(distribution_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("DELETE", url, "", nil, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "", nil, 204)
 
 		if err != nil {
 			return err
@@ -7520,67 +7501,62 @@ func (client *Client) ReleaseDelete(account_id, distribution_id, id string) erro
 
 		return nil
 	}()
+	endSpan(span, err)
 	return err
 }
 
 // Publish a release for production.
 func (client *Client) ReleasePublish(account_id, distribution_id, id string) (*Release, error) {
 	retVal := new(Release)
+	ctx, span := client.startSpan("ReleasePublish", "account_id", account_id, "distribution_id", distribution_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/distributions/%s/releases/%s/publish", url.QueryEscape(account_id), url.QueryEscape(distribution_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("POST", url, "", nil, 200)
+		rc, err := client.sendRequest(ctx, "POST", url, "", nil, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Get details on a single release.
 func (client *Client) ReleaseShow(account_id, distribution_id, id string) (*Release, error) {
 	retVal := new(Release)
+	ctx, span := client.startSpan("ReleaseShow", "account_id", account_id, "distribution_id", distribution_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/distributions/%s/releases/%s", url.QueryEscape(account_id), url.QueryEscape(distribution_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("GET", url, "", nil, 200)
+		rc, err := client.sendRequest(ctx, "GET", url, "", nil, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Update an existing release.
 func (client *Client) ReleaseUpdate(account_id, distribution_id, id string, params *ReleasesParams) (*Release, error) {
 	retVal := new(Release)
+	ctx, span := client.startSpan("ReleaseUpdate", "account_id", account_id, "distribution_id", distribution_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/accounts/%s/distributions/%s/releases/%s", url.QueryEscape(account_id), url.QueryEscape(distribution_id), url.QueryEscape(id))
 
@@ -7590,60 +7566,60 @@ func (client *Client) ReleaseUpdate(account_id, distribution_id, id string, para
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // List all releases for the given distribution.
 func (client *Client) ReleasesList(account_id, distribution_id string, page, perPage int) ([]*ReleasePreview, error) {
 	retVal := []*ReleasePreview{}
+	ctx, span := client.startSpan("ReleasesList", "account_id", account_id, "distribution_id", distribution_id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/distributions/%s/releases", url.QueryEscape(account_id), url.QueryEscape(distribution_id))
 
-		rc, err := client.sendRequestPaginated("GET", url, "", nil, 200, page, perPage)
+		rc, err := client.sendRequestPaginated(ctx, "GET", url, "", nil, 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Create a new screenshot.
 func (client *Client) ScreenshotCreate(project_id string, params *ScreenshotParams) (*Screenshot, error) {
 	retVal := new(Screenshot)
-	err := func() error {
+	ctx, span := client.startSpan("ScreenshotCreate", "project_id", project_id)
+	err := func() (err error) {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/screenshots", url.QueryEscape(project_id))
 
+		_, buildSpan := startChildSpan(ctx, "multipart")
+		defer func() {
+			if buildSpan != nil {
+				endSpan(buildSpan, err)
+			}
+		}()
 		paramsBuf := bytes.NewBuffer(nil)
 		writer := multipart.NewWriter(paramsBuf)
 		ctype := writer.FormDataContentType()
@@ -7680,37 +7656,35 @@ func (client *Client) ScreenshotCreate(project_id string, params *ScreenshotPara
 				return err
 			}
 		}
-		err := writer.WriteField("utf8", "✓")
+		err = writer.WriteField("utf8", "✓")
 		writer.Close()
+		buildSpan.End()
+		buildSpan = nil
 
-		rc, err := client.sendRequest("POST", url, ctype, paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, ctype, paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Delete an existing screenshot.
 func (client *Client) ScreenshotDelete(project_id, id string) error {
 
+	ctx, span := client.startSpan("ScreenshotDelete", "project_id", project_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s/screenshots/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("DELETE", url, "", nil, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "", nil, 204)
 
 		if err != nil {
 			return err
@@ -7719,43 +7693,49 @@ func (client *Client) ScreenshotDelete(project_id, id string) error {
 
 		return nil
 	}()
+	endSpan(span, err)
 	return err
 }
 
 // Get details on a single screenshot for a given project.
 func (client *Client) ScreenshotShow(project_id, id string) (*Screenshot, error) {
 	retVal := new(Screenshot)
+	ctx, span := client.startSpan("ScreenshotShow", "project_id", project_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s/screenshots/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("GET", url, "", nil, 200)
+		rc, err := client.sendRequest(ctx, "GET", url, "", nil, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Update an existing screenshot.
 func (client *Client) ScreenshotUpdate(project_id, id string, params *ScreenshotParams) (*Screenshot, error) {
 	retVal := new(Screenshot)
-	err := func() error {
+	ctx, span := client.startSpan("ScreenshotUpdate", "project_id", project_id, "id", id)
+	err := func() (err error) {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/screenshots/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
+		_, buildSpan := startChildSpan(ctx, "multipart")
+		defer func() {
+			if buildSpan != nil {
+				endSpan(buildSpan, err)
+			}
+		}()
 		paramsBuf := bytes.NewBuffer(nil)
 		writer := multipart.NewWriter(paramsBuf)
 		ctype := writer.FormDataContentType()
@@ -7792,33 +7772,34 @@ func (client *Client) ScreenshotUpdate(project_id, id string, params *Screenshot
 				return err
 			}
 		}
-		err := writer.WriteField("utf8", "✓")
+		err = writer.WriteField("utf8", "✓")
 		writer.Close()
+		buildSpan.End()
+		buildSpan = nil
 
-		rc, err := client.sendRequest("PATCH", url, ctype, paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, ctype, paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Create a new screenshot marker.
 func (client *Client) ScreenshotMarkerCreate(project_id, screenshot_id string, params *ScreenshotMarkerParams) (*ScreenshotMarker, error) {
 	retVal := new(ScreenshotMarker)
+	ctx, span := client.startSpan("ScreenshotMarkerCreate", "project_id", project_id, "screenshot_id", screenshot_id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/screenshots/%s/markers", url.QueryEscape(project_id), url.QueryEscape(screenshot_id))
 
@@ -7828,34 +7809,30 @@ func (client *Client) ScreenshotMarkerCreate(project_id, screenshot_id string, p
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Delete an existing screenshot marker.
 func (client *Client) ScreenshotMarkerDelete(project_id, screenshot_id string) error {
 
+	ctx, span := client.startSpan("ScreenshotMarkerDelete", "project_id", project_id, "screenshot_id", screenshot_id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s/screenshots/%s/markers", url.QueryEscape(project_id), url.QueryEscape(screenshot_id))
 
-		rc, err := client.sendRequest("DELETE", url, "", nil, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "", nil, 204)
 
 		if err != nil {
 			return err
@@ -7864,40 +7841,40 @@ func (client *Client) ScreenshotMarkerDelete(project_id, screenshot_id string) e
 
 		return nil
 	}()
+	endSpan(span, err)
 	return err
 }
 
 // Get details on a single screenshot marker for a given project.
 func (client *Client) ScreenshotMarkerShow(project_id, screenshot_id, id string) (*ScreenshotMarker, error) {
 	retVal := new(ScreenshotMarker)
+	ctx, span := client.startSpan("ScreenshotMarkerShow", "project_id", project_id, "screenshot_id", screenshot_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s/screenshots/%s/markers/%s", url.QueryEscape(project_id), url.QueryEscape(screenshot_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("GET", url, "", nil, 200)
+		rc, err := client.sendRequest(ctx, "GET", url, "", nil, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Update an existing screenshot marker.
 func (client *Client) ScreenshotMarkerUpdate(project_id, screenshot_id string, params *ScreenshotMarkerParams) (*ScreenshotMarker, error) {
 	retVal := new(ScreenshotMarker)
+	ctx, span := client.startSpan("ScreenshotMarkerUpdate", "project_id", project_id, "screenshot_id", screenshot_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/screenshots/%s/markers", url.QueryEscape(project_id), url.QueryEscape(screenshot_id))
 
@@ -7907,104 +7884,84 @@ func (client *Client) ScreenshotMarkerUpdate(project_id, screenshot_id string, p
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // List all screenshot markers for the given project.
 func (client *Client) ScreenshotMarkersList(project_id, id string, page, perPage int) ([]*ScreenshotMarker, error) {
 	retVal := []*ScreenshotMarker{}
+	ctx, span := client.startSpan("ScreenshotMarkersList", "project_id", project_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s/screenshots/%s/markers", url.QueryEscape(project_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequestPaginated("GET", url, "", nil, 200, page, perPage)
+		rc, err := client.sendRequestPaginated(ctx, "GET", url, "", nil, 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // List all screenshots for the given project.
 func (client *Client) ScreenshotsList(project_id string, page, perPage int) ([]*Screenshot, error) {
 	retVal := []*Screenshot{}
+	ctx, span := client.startSpan("ScreenshotsList", "project_id", project_id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s/screenshots", url.QueryEscape(project_id))
 
-		rc, err := client.sendRequestPaginated("GET", url, "", nil, 200, page, perPage)
+		rc, err := client.sendRequestPaginated(ctx, "GET", url, "", nil, 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Show details for current User.
 func (client *Client) ShowUser() (*User, error) {
 	retVal := new(User)
+	ctx, span := client.startSpan("ShowUser")
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/user")
 
-		rc, err := client.sendRequest("GET", url, "", nil, 200)
+		rc, err := client.sendRequest(ctx, "GET", url, "", nil, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -8043,7 +8000,11 @@ func (params *SpaceCreateParams) QueryParams() map[string]string {
 // Create a new Space.
 func (client *Client) SpaceCreate(account_id string, params *SpaceCreateParams) (*Space, error) {
 	retVal := new(Space)
+	ctx, span := client.startSpan("SpaceCreate", "account_id", account_id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/accounts/%s/spaces", url.QueryEscape(account_id))
 
@@ -8053,34 +8014,30 @@ func (client *Client) SpaceCreate(account_id string, params *SpaceCreateParams)
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Delete the specified Space.
 func (client *Client) SpaceDelete(account_id, id string) error {
 
+	ctx, span := client.startSpan("SpaceDelete", "account_id", account_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/spaces/%s", url.QueryEscape(account_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("DELETE", url, "", nil, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "", nil, 204)
 
 		if err != nil {
 			return err
@@ -8089,33 +8046,29 @@ func (client *Client) SpaceDelete(account_id, id string) error {
 
 		return nil
 	}()
+	endSpan(span, err)
 	return err
 }
 
 // Show the specified Space.
 func (client *Client) SpaceShow(account_id, id string) (*Space, error) {
 	retVal := new(Space)
+	ctx, span := client.startSpan("SpaceShow", "account_id", account_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/spaces/%s", url.QueryEscape(account_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("GET", url, "", nil, 200)
+		rc, err := client.sendRequest(ctx, "GET", url, "", nil, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -8154,7 +8107,11 @@ func (params *SpaceUpdateParams) QueryParams() map[string]string {
 // Update the specified Space.
 func (client *Client) SpaceUpdate(account_id, id string, params *SpaceUpdateParams) (*Space, error) {
 	retVal := new(Space)
+	ctx, span := client.startSpan("SpaceUpdate", "account_id", account_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/accounts/%s/spaces/%s", url.QueryEscape(account_id), url.QueryEscape(id))
 
@@ -8164,50 +8121,40 @@ func (client *Client) SpaceUpdate(account_id, id string, params *SpaceUpdatePara
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // List all Spaces for the given account.
 func (client *Client) SpacesList(account_id string, page, perPage int) ([]*Space, error) {
 	retVal := []*Space{}
+	ctx, span := client.startSpan("SpacesList", "account_id", account_id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/spaces", url.QueryEscape(account_id))
 
-		rc, err := client.sendRequestPaginated("GET", url, "", nil, 200, page, perPage)
+		rc, err := client.sendRequestPaginated(ctx, "GET", url, "", nil, 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -8246,7 +8193,11 @@ func (params *SpacesProjectsCreateParams) QueryParams() map[string]string {
 // Adds an existing project to the space.
 func (client *Client) SpacesProjectsCreate(account_id, space_id string, params *SpacesProjectsCreateParams) error {
 
+	ctx, span := client.startSpan("SpacesProjectsCreate", "account_id", account_id, "space_id", space_id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/accounts/%s/spaces/%s/projects", url.QueryEscape(account_id), url.QueryEscape(space_id))
 
@@ -8256,7 +8207,7 @@ func (client *Client) SpacesProjectsCreate(account_id, space_id string, params *
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
@@ -8265,17 +8216,20 @@ func (client *Client) SpacesProjectsCreate(account_id, space_id string, params *
 
 		return nil
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return err
 }
 
 // Removes a specified project from the specified space.
 func (client *Client) SpacesProjectsDelete(account_id, space_id, id string) error {
 
+	ctx, span := client.startSpan("SpacesProjectsDelete", "account_id", account_id, "space_id", space_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/spaces/%s/projects/%s", url.QueryEscape(account_id), url.QueryEscape(space_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("DELETE", url, "", nil, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "", nil, 204)
 
 		if err != nil {
 			return err
@@ -8284,40 +8238,40 @@ func (client *Client) SpacesProjectsDelete(account_id, space_id, id string) erro
 
 		return nil
 	}()
+	endSpan(span, err)
 	return err
 }
 
 // List all projects for the specified Space.
 func (client *Client) SpacesProjectsList(account_id, space_id string, page, perPage int) ([]*Project, error) {
 	retVal := []*Project{}
+	ctx, span := client.startSpan("SpacesProjectsList", "account_id", account_id, "space_id", space_id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/accounts/%s/spaces/%s/projects", url.QueryEscape(account_id), url.QueryEscape(space_id))
 
-		rc, err := client.sendRequestPaginated("GET", url, "", nil, 200, page, perPage)
+		rc, err := client.sendRequestPaginated(ctx, "GET", url, "", nil, 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Create a new style guide.
 func (client *Client) StyleguideCreate(project_id string, params *StyleguideParams) (*StyleguideDetails, error) {
 	retVal := new(StyleguideDetails)
+	ctx, span := client.startSpan("StyleguideCreate", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/styleguides", url.QueryEscape(project_id))
 
@@ -8327,34 +8281,30 @@ func (client *Client) StyleguideCreate(project_id string, params *StyleguidePara
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Delete an existing style guide.
 func (client *Client) StyleguideDelete(project_id, id string) error {
 
+	ctx, span := client.startSpan("StyleguideDelete", "project_id", project_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s/styleguides/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("DELETE", url, "", nil, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "", nil, 204)
 
 		if err != nil {
 			return err
@@ -8363,40 +8313,40 @@ func (client *Client) StyleguideDelete(project_id, id string) error {
 
 		return nil
 	}()
+	endSpan(span, err)
 	return err
 }
 
 // Get details on a single style guide.
 func (client *Client) StyleguideShow(project_id, id string) (*StyleguideDetails, error) {
 	retVal := new(StyleguideDetails)
+	ctx, span := client.startSpan("StyleguideShow", "project_id", project_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s/styleguides/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("GET", url, "", nil, 200)
+		rc, err := client.sendRequest(ctx, "GET", url, "", nil, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Update an existing style guide.
 func (client *Client) StyleguideUpdate(project_id, id string, params *StyleguideParams) (*StyleguideDetails, error) {
 	retVal := new(StyleguideDetails)
+	ctx, span := client.startSpan("StyleguideUpdate", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/styleguides/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -8406,57 +8356,51 @@ func (client *Client) StyleguideUpdate(project_id, id string, params *Styleguide
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // List all styleguides for the given project.
 func (client *Client) StyleguidesList(project_id string, page, perPage int) ([]*Styleguide, error) {
 	retVal := []*Styleguide{}
+	ctx, span := client.startSpan("StyleguidesList", "project_id", project_id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s/styleguides", url.QueryEscape(project_id))
 
-		rc, err := client.sendRequestPaginated("GET", url, "", nil, 200, page, perPage)
+		rc, err := client.sendRequestPaginated(ctx, "GET", url, "", nil, 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Create a new tag.
 func (client *Client) TagCreate(project_id string, params *TagParams) (*TagWithStats, error) {
 	retVal := new(TagWithStats)
+	ctx, span := client.startSpan("TagCreate", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/tags", url.QueryEscape(project_id))
 
@@ -8466,23 +8410,18 @@ func (client *Client) TagCreate(project_id string, params *TagParams) (*TagWithS
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -8521,7 +8460,11 @@ func (params *TagDeleteParams) QueryParams() map[string]string {
 // Delete an existing tag.
 func (client *Client) TagDelete(project_id, name string, params *TagDeleteParams) error {
 
+	ctx, span := client.startSpan("TagDelete", "project_id", project_id, "name", name)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/tags/%s", url.QueryEscape(project_id), url.QueryEscape(name))
 
@@ -8531,7 +8474,7 @@ func (client *Client) TagDelete(project_id, name string, params *TagDeleteParams
 			return err
 		}
 
-		rc, err := client.sendRequest("DELETE", url, "application/json", paramsBuf, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "application/json", paramsBuf, 204)
 
 		if err != nil {
 			return err
@@ -8540,6 +8483,8 @@ func (client *Client) TagDelete(project_id, name string, params *TagDeleteParams
 
 		return nil
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return err
 }
 
@@ -8578,27 +8523,26 @@ func (params *TagShowParams) QueryParams() map[string]string {
 // Get details and progress information on a single tag for a given project.
 func (client *Client) TagShow(project_id, name string, params *TagShowParams) (*TagWithStats, error) {
 	retVal := new(TagWithStats)
+	ctx, span := client.startSpan("TagShow", "project_id", project_id, "name", name)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/tags/%s", url.QueryEscape(project_id), url.QueryEscape(name))
 
-		rc, err := client.sendGetRequest(url, params.QueryParams(), 200)
+		rc, err := client.sendGetRequest(ctx, url, params.QueryParams(), 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -8637,34 +8581,37 @@ func (params *TagsListParams) QueryParams() map[string]string {
 // List all tags for the given project.
 func (client *Client) TagsList(project_id string, page, perPage int, params *TagsListParams) ([]*Tag, error) {
 	retVal := []*Tag{}
+	ctx, span := client.startSpan("TagsList", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/tags", url.QueryEscape(project_id))
 
-		rc, err := client.sendGetRequestPaginated(url, params.QueryParams(), 200, page, perPage)
+		rc, err := client.sendGetRequestPaginated(ctx, url, params.QueryParams(), 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Create a translation.
 func (client *Client) TranslationCreate(project_id string, params *TranslationParams) (*TranslationDetails, error) {
 	retVal := new(TranslationDetails)
+	ctx, span := client.startSpan("TranslationCreate", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/translations", url.QueryEscape(project_id))
 
@@ -8674,23 +8621,18 @@ func (client *Client) TranslationCreate(project_id string, params *TranslationPa
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -8729,7 +8671,11 @@ func (params *TranslationExcludeParams) QueryParams() map[string]string {
 // Set exclude from export flag on an existing translation.
 func (client *Client) TranslationExclude(project_id, id string, params *TranslationExcludeParams) (*TranslationDetails, error) {
 	retVal := new(TranslationDetails)
+	ctx, span := client.startSpan("TranslationExclude", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/translations/%s/exclude", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -8739,23 +8685,18 @@ func (client *Client) TranslationExclude(project_id, id string, params *Translat
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -8794,7 +8735,11 @@ func (params *TranslationIncludeParams) QueryParams() map[string]string {
 // Remove exclude from export flag from an existing translation.
 func (client *Client) TranslationInclude(project_id, id string, params *TranslationIncludeParams) (*TranslationDetails, error) {
 	retVal := new(TranslationDetails)
+	ctx, span := client.startSpan("TranslationInclude", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/translations/%s/include", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -8804,23 +8749,18 @@ func (client *Client) TranslationInclude(project_id, id string, params *Translat
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -8859,7 +8799,11 @@ func (params *TranslationReviewParams) QueryParams() map[string]string {
 // Mark an existing translation as reviewed.
 func (client *Client) TranslationReview(project_id, id string, params *TranslationReviewParams) (*TranslationDetails, error) {
 	retVal := new(TranslationDetails)
+	ctx, span := client.startSpan("TranslationReview", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/translations/%s/review", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -8869,23 +8813,18 @@ func (client *Client) TranslationReview(project_id, id string, params *Translati
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -8924,27 +8863,26 @@ func (params *TranslationShowParams) QueryParams() map[string]string {
 // Get details on a single translation.
 func (client *Client) TranslationShow(project_id, id string, params *TranslationShowParams) (*TranslationDetails, error) {
 	retVal := new(TranslationDetails)
+	ctx, span := client.startSpan("TranslationShow", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/translations/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
-		rc, err := client.sendGetRequest(url, params.QueryParams(), 200)
+		rc, err := client.sendGetRequest(ctx, url, params.QueryParams(), 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -8983,7 +8921,11 @@ func (params *TranslationUnverifyParams) QueryParams() map[string]string {
 // Mark an existing translation as unverified.
 func (client *Client) TranslationUnverify(project_id, id string, params *TranslationUnverifyParams) (*TranslationDetails, error) {
 	retVal := new(TranslationDetails)
+	ctx, span := client.startSpan("TranslationUnverify", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/translations/%s/unverify", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -8993,23 +8935,18 @@ func (client *Client) TranslationUnverify(project_id, id string, params *Transla
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -9108,7 +9045,11 @@ func (params *TranslationUpdateParams) QueryParams() map[string]string {
 // Update an existing translation.
 func (client *Client) TranslationUpdate(project_id, id string, params *TranslationUpdateParams) (*TranslationDetails, error) {
 	retVal := new(TranslationDetails)
+	ctx, span := client.startSpan("TranslationUpdate", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/translations/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -9118,23 +9059,18 @@ func (client *Client) TranslationUpdate(project_id, id string, params *Translati
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -9173,7 +9109,11 @@ func (params *TranslationVerifyParams) QueryParams() map[string]string {
 // Verify an existing translation.
 func (client *Client) TranslationVerify(project_id, id string, params *TranslationVerifyParams) (*TranslationDetails, error) {
 	retVal := new(TranslationDetails)
+	ctx, span := client.startSpan("TranslationVerify", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/translations/%s/verify", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -9183,23 +9123,18 @@ func (client *Client) TranslationVerify(project_id, id string, params *Translati
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -9274,27 +9209,26 @@ func (params *TranslationsByKeyParams) QueryParams() map[string]string {
 // List translations for a specific key.
 func (client *Client) TranslationsByKey(project_id, key_id string, page, perPage int, params *TranslationsByKeyParams) ([]*Translation, error) {
 	retVal := []*Translation{}
+	ctx, span := client.startSpan("TranslationsByKey", "project_id", project_id, "key_id", key_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/keys/%s/translations", url.QueryEscape(project_id), url.QueryEscape(key_id))
 
-		rc, err := client.sendGetRequestPaginated(url, params.QueryParams(), 200, page, perPage)
+		rc, err := client.sendGetRequestPaginated(ctx, url, params.QueryParams(), 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -9369,27 +9303,26 @@ func (params *TranslationsByLocaleParams) QueryParams() map[string]string {
 // List translations for a specific locale. If you want to download all translations for one locale we recommend to use the <code>locales#download</code> endpoint.
 func (client *Client) TranslationsByLocale(project_id, locale_id string, page, perPage int, params *TranslationsByLocaleParams) ([]*Translation, error) {
 	retVal := []*Translation{}
+	ctx, span := client.startSpan("TranslationsByLocale", "project_id", project_id, "locale_id", locale_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/locales/%s/translations", url.QueryEscape(project_id), url.QueryEscape(locale_id))
 
-		rc, err := client.sendGetRequestPaginated(url, params.QueryParams(), 200, page, perPage)
+		rc, err := client.sendGetRequestPaginated(ctx, url, params.QueryParams(), 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -9464,7 +9397,11 @@ func (params *TranslationsExcludeParams) QueryParams() map[string]string {
 // Exclude translations matching query from locale export.
 func (client *Client) TranslationsExclude(project_id string, params *TranslationsExcludeParams) (*AffectedCount, error) {
 	retVal := new(AffectedCount)
+	ctx, span := client.startSpan("TranslationsExclude", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/translations/exclude", url.QueryEscape(project_id))
 
@@ -9474,23 +9411,18 @@ func (client *Client) TranslationsExclude(project_id string, params *Translation
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -9565,7 +9497,11 @@ func (params *TranslationsIncludeParams) QueryParams() map[string]string {
 // Include translations matching query in locale export.
 func (client *Client) TranslationsInclude(project_id string, params *TranslationsIncludeParams) (*AffectedCount, error) {
 	retVal := new(AffectedCount)
+	ctx, span := client.startSpan("TranslationsInclude", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/translations/include", url.QueryEscape(project_id))
 
@@ -9575,23 +9511,18 @@ func (client *Client) TranslationsInclude(project_id string, params *Translation
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -9666,27 +9597,26 @@ func (params *TranslationsListParams) QueryParams() map[string]string {
 // List translations for the given project. If you want to download all translations for one locale we recommend to use the <code>locales#download</code> endpoint.
 func (client *Client) TranslationsList(project_id string, page, perPage int, params *TranslationsListParams) ([]*Translation, error) {
 	retVal := []*Translation{}
+	ctx, span := client.startSpan("TranslationsList", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/translations", url.QueryEscape(project_id))
 
-		rc, err := client.sendGetRequestPaginated(url, params.QueryParams(), 200, page, perPage)
+		rc, err := client.sendGetRequestPaginated(ctx, url, params.QueryParams(), 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -9737,7 +9667,11 @@ func (params *TranslationsReviewParams) QueryParams() map[string]string {
 // Review translations matching query.
 func (client *Client) TranslationsReview(project_id string, params *TranslationsReviewParams) (*AffectedCount, error) {
 	retVal := new(AffectedCount)
+	ctx, span := client.startSpan("TranslationsReview", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/translations/review", url.QueryEscape(project_id))
 
@@ -9747,23 +9681,18 @@ func (client *Client) TranslationsReview(project_id string, params *Translations
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -9838,7 +9767,11 @@ func (params *TranslationsSearchParams) QueryParams() map[string]string {
 // Search translations for the given project. Provides the same search interface as <code>translations#index</code> but allows POST requests to avoid limitations imposed by GET requests. If you want to download all translations for one locale we recommend to use the <code>locales#download</code> endpoint.
 func (client *Client) TranslationsSearch(project_id string, page, perPage int, params *TranslationsSearchParams) ([]*Translation, error) {
 	retVal := []*Translation{}
+	ctx, span := client.startSpan("TranslationsSearch", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/translations/search", url.QueryEscape(project_id))
 
@@ -9848,23 +9781,18 @@ func (client *Client) TranslationsSearch(project_id string, page, perPage int, p
 			return err
 		}
 
-		rc, err := client.sendRequestPaginated("POST", url, "application/json", paramsBuf, 200, page, perPage)
+		rc, err := client.sendRequestPaginated(ctx, "POST", url, "application/json", paramsBuf, 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -9939,7 +9867,11 @@ func (params *TranslationsUnverifyParams) QueryParams() map[string]string {
 // Mark translations matching query as unverified.
 func (client *Client) TranslationsUnverify(project_id string, params *TranslationsUnverifyParams) (*AffectedCount, error) {
 	retVal := new(AffectedCount)
+	ctx, span := client.startSpan("TranslationsUnverify", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/translations/unverify", url.QueryEscape(project_id))
 
@@ -9949,23 +9881,18 @@ func (client *Client) TranslationsUnverify(project_id string, params *Translatio
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -10040,7 +9967,11 @@ func (params *TranslationsVerifyParams) QueryParams() map[string]string {
 // Verify translations matching query.
 func (client *Client) TranslationsVerify(project_id string, params *TranslationsVerifyParams) (*AffectedCount, error) {
 	retVal := new(AffectedCount)
+	ctx, span := client.startSpan("TranslationsVerify", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/translations/verify", url.QueryEscape(project_id))
 
@@ -10050,33 +9981,40 @@ func (client *Client) TranslationsVerify(project_id string, params *Translations
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Upload a new language file. Creates necessary resources in your project.
+// Without a FileFormat the format of the file is detected, see DetectFormat.
 func (client *Client) UploadCreate(project_id string, params *UploadParams) (*Upload, error) {
 	retVal := new(Upload)
-	err := func() error {
+	ctx, span := client.startSpan("UploadCreate", "project_id", project_id)
+	params = client.detectUploadFormat(params)
+	err := func() (err error) {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/uploads", url.QueryEscape(project_id))
 
+		_, buildSpan := startChildSpan(ctx, "multipart")
+		defer func() {
+			if buildSpan != nil {
+				endSpan(buildSpan, err)
+			}
+		}()
 		paramsBuf := bytes.NewBuffer(nil)
 		writer := multipart.NewWriter(paramsBuf)
 		ctype := writer.FormDataContentType()
@@ -10129,7 +10067,7 @@ func (client *Client) UploadCreate(project_id string, params *UploadParams) (*Up
 		}
 
 		if params.FileFormat != nil {
-			err := writer.WriteField("file_format", *params.FileFormat)
+			err := writer.WriteField("file_format", string(*params.FileFormat))
 			if err != nil {
 				return err
 			}
@@ -10201,26 +10139,23 @@ func (client *Client) UploadCreate(project_id string, params *UploadParams) (*Up
 				return err
 			}
 		}
-		err := writer.WriteField("utf8", "✓")
+		err = writer.WriteField("utf8", "✓")
 		writer.Close()
+		buildSpan.End()
+		buildSpan = nil
 
-		rc, err := client.sendRequest("POST", url, ctype, paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, ctype, paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -10259,27 +10194,26 @@ func (params *UploadShowParams) QueryParams() map[string]string {
 // View details and summary for a single upload.
 func (client *Client) UploadShow(project_id, id string, params *UploadShowParams) (*Upload, error) {
 	retVal := new(Upload)
+	ctx, span := client.startSpan("UploadShow", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/uploads/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
-		rc, err := client.sendGetRequest(url, params.QueryParams(), 200)
+		rc, err := client.sendGetRequest(ctx, url, params.QueryParams(), 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -10318,27 +10252,26 @@ func (params *UploadsListParams) QueryParams() map[string]string {
 // List all uploads for the given project.
 func (client *Client) UploadsList(project_id string, page, perPage int, params *UploadsListParams) ([]*Upload, error) {
 	retVal := []*Upload{}
+	ctx, span := client.startSpan("UploadsList", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/uploads", url.QueryEscape(project_id))
 
-		rc, err := client.sendGetRequestPaginated(url, params.QueryParams(), 200, page, perPage)
+		rc, err := client.sendGetRequestPaginated(ctx, url, params.QueryParams(), 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -10377,27 +10310,26 @@ func (params *VersionShowParams) QueryParams() map[string]string {
 // Get details on a single version.
 func (client *Client) VersionShow(project_id, translation_id, id string, params *VersionShowParams) (*TranslationVersionWithUser, error) {
 	retVal := new(TranslationVersionWithUser)
+	ctx, span := client.startSpan("VersionShow", "project_id", project_id, "translation_id", translation_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/translations/%s/versions/%s", url.QueryEscape(project_id), url.QueryEscape(translation_id), url.QueryEscape(id))
 
-		rc, err := client.sendGetRequest(url, params.QueryParams(), 200)
+		rc, err := client.sendGetRequest(ctx, url, params.QueryParams(), 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -10436,34 +10368,37 @@ func (params *VersionsListParams) QueryParams() map[string]string {
 // List all versions for the given translation.
 func (client *Client) VersionsList(project_id, translation_id string, page, perPage int, params *VersionsListParams) ([]*TranslationVersion, error) {
 	retVal := []*TranslationVersion{}
+	ctx, span := client.startSpan("VersionsList", "project_id", project_id, "translation_id", translation_id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/translations/%s/versions", url.QueryEscape(project_id), url.QueryEscape(translation_id))
 
-		rc, err := client.sendGetRequestPaginated(url, params.QueryParams(), 200, page, perPage)
+		rc, err := client.sendGetRequestPaginated(ctx, url, params.QueryParams(), 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Create a new webhook.
 func (client *Client) WebhookCreate(project_id string, params *WebhookParams) (*Webhook, error) {
 	retVal := new(Webhook)
+	ctx, span := client.startSpan("WebhookCreate", "project_id", project_id)
 	err := func() error {
+		if err := client.validate(params, true); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/webhooks", url.QueryEscape(project_id))
 
@@ -10473,34 +10408,30 @@ func (client *Client) WebhookCreate(project_id string, params *WebhookParams) (*
 			return err
 		}
 
-		rc, err := client.sendRequest("POST", url, "application/json", paramsBuf, 201)
+		rc, err := client.sendRequest(ctx, "POST", url, "application/json", paramsBuf, 201)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Delete an existing webhook.
 func (client *Client) WebhookDelete(project_id, id string) error {
 
+	ctx, span := client.startSpan("WebhookDelete", "project_id", project_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s/webhooks/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("DELETE", url, "", nil, 204)
+		rc, err := client.sendRequest(ctx, "DELETE", url, "", nil, 204)
 
 		if err != nil {
 			return err
@@ -10509,44 +10440,41 @@ func (client *Client) WebhookDelete(project_id, id string) error {
 
 		return nil
 	}()
+	endSpan(span, err)
 	return err
 }
 
 // Get details on a single webhook.
 func (client *Client) WebhookShow(project_id, id string) (*Webhook, error) {
 	retVal := new(Webhook)
+	ctx, span := client.startSpan("WebhookShow", "project_id", project_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s/webhooks/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("GET", url, "", nil, 200)
+		rc, err := client.sendRequest(ctx, "GET", url, "", nil, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
 // Perform a test request for a webhook.
 func (client *Client) WebhookTest(project_id, id string) error {
 
+	ctx, span := client.startSpan("WebhookTest", "project_id", project_id, "id", id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s/webhooks/%s/test", url.QueryEscape(project_id), url.QueryEscape(id))
 
-		rc, err := client.sendRequest("POST", url, "", nil, 200)
+		rc, err := client.sendRequest(ctx, "POST", url, "", nil, 200)
 
 		if err != nil {
 			return err
@@ -10555,13 +10483,18 @@ func (client *Client) WebhookTest(project_id, id string) error {
 
 		return nil
 	}()
+	endSpan(span, err)
 	return err
 }
 
 // Update an existing webhook.
 func (client *Client) WebhookUpdate(project_id, id string, params *WebhookParams) (*Webhook, error) {
 	retVal := new(Webhook)
+	ctx, span := client.startSpan("WebhookUpdate", "project_id", project_id, "id", id)
 	err := func() error {
+		if err := client.validate(params, false); err != nil {
+			return err
+		}
 
 		url := fmt.Sprintf("/v2/projects/%s/webhooks/%s", url.QueryEscape(project_id), url.QueryEscape(id))
 
@@ -10571,50 +10504,40 @@ func (client *Client) WebhookUpdate(project_id, id string, params *WebhookParams
 			return err
 		}
 
-		rc, err := client.sendRequest("PATCH", url, "application/json", paramsBuf, 200)
+		rc, err := client.sendRequest(ctx, "PATCH", url, "application/json", paramsBuf, 200)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	err = attachParams(err, params)
+	endSpan(span, err)
 	return retVal, err
 }
 
 // List all webhooks for the given project.
 func (client *Client) WebhooksList(project_id string, page, perPage int) ([]*Webhook, error) {
 	retVal := []*Webhook{}
+	ctx, span := client.startSpan("WebhooksList", "project_id", project_id)
 	err := func() error {
 
 		url := fmt.Sprintf("/v2/projects/%s/webhooks", url.QueryEscape(project_id))
 
-		rc, err := client.sendRequestPaginated("GET", url, "", nil, 200, page, perPage)
+		rc, err := client.sendRequestPaginated(ctx, "GET", url, "", nil, 200, page, perPage)
 
 		if err != nil {
 			return err
 		}
 		defer rc.Close()
 
-		var reader io.Reader
-		if client.debug {
-			reader = io.TeeReader(rc, os.Stderr)
-		} else {
-			reader = rc
-		}
-
-		return json.NewDecoder(reader).Decode(&retVal)
+		return decodeJSON(ctx, rc, &retVal)
 
 	}()
+	endSpan(span, err)
 	return retVal, err
 }
 
@@ -0,0 +1,118 @@
+package phraseapp
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LogLevel is the severity of a log event.
+type LogLevel int
+
+const (
+	LevelDebug LogLevel = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+func (level LogLevel) String() string {
+	switch level {
+	case LevelDebug:
+		return "DEBUG"
+	case LevelInfo:
+		return "INFO"
+	case LevelWarn:
+		return "WARN"
+	case LevelError:
+		return "ERROR"
+	}
+	return fmt.Sprintf("LEVEL(%d)", int(level))
+}
+
+// LogField is a key/value pair attached to a log event.
+type LogField struct {
+	Key   string
+	Value interface{}
+}
+
+// Logger receives structured log events from Client and the HTTP cache.
+// Its shape follows log/slog handlers so it is easy to bridge: Enabled is
+// asked before expensive fields (like request bodies) are collected.
+//
+// Events emitted are "request", "request headers", "response", "response
+// body", "request failed", the cache events "cache miss", "cache
+// revalidate" and "cache hit" and the upload events "format detected" and
+// "format detection failed". Fields used are method, path, url, status,
+// duration, bytes, header, body, etag, not_modified, attempt, file, format,
+// reason and error.
+type Logger interface {
+	Enabled(level LogLevel) bool
+	Log(level LogLevel, msg string, fields ...LogField)
+}
+
+// WriterLogger writes log events with at least Level as text lines to W.
+type WriterLogger struct {
+	W     io.Writer
+	Level LogLevel
+
+	mu sync.Mutex
+}
+
+// NewWriterLogger returns a text logger writing to w.
+func NewWriterLogger(w io.Writer, level LogLevel) *WriterLogger {
+	return &WriterLogger{W: w, Level: level}
+}
+
+func (l *WriterLogger) Enabled(level LogLevel) bool {
+	return level >= l.Level
+}
+
+func (l *WriterLogger) Log(level LogLevel, msg string, fields ...LogField) {
+	if !l.Enabled(level) {
+		return
+	}
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "%s %s %s", time.Now().Format(time.RFC3339), level, msg)
+	for _, f := range fields {
+		switch v := f.Value.(type) {
+		case string:
+			if strings.ContainsAny(v, " \n\t\"=") {
+				fmt.Fprintf(buf, " %s=%q", f.Key, v)
+			} else {
+				fmt.Fprintf(buf, " %s=%s", f.Key, v)
+			}
+		default:
+			fmt.Fprintf(buf, " %s=%v", f.Key, v)
+		}
+	}
+	buf.WriteByte('\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.W.Write(buf.Bytes())
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Enabled(LogLevel) bool             { return false }
+func (nopLogger) Log(LogLevel, string, ...LogField) {}
+
+var debugLogger = NewWriterLogger(os.Stderr, LevelDebug)
+
+// logger returns the configured logger. Without one, debug clients log
+// everything to stderr and other clients log nothing.
+func (client *Client) logger() Logger {
+	switch {
+	case client.Logger != nil:
+		return client.Logger
+	case client.debug:
+		return debugLogger
+	}
+	return nopLogger{}
+}
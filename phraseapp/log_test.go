@@ -0,0 +1,79 @@
+package phraseapp
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type logEntry struct {
+	level  LogLevel
+	msg    string
+	fields map[string]interface{}
+}
+
+type testLogger struct {
+	level   LogLevel
+	entries []logEntry
+}
+
+func (l *testLogger) Enabled(level LogLevel) bool { return level >= l.level }
+
+func (l *testLogger) Log(level LogLevel, msg string, fields ...LogField) {
+	m := map[string]interface{}{}
+	for _, f := range fields {
+		m[f.Key] = f.Value
+	}
+	l.entries = append(l.entries, logEntry{level, msg, m})
+}
+
+func TestClientLogger(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `{"id":"u1"}`)
+	}))
+	defer server.Close()
+
+	logger := &testLogger{level: LevelInfo}
+	client, _ := NewClient(Credentials{Host: server.URL, Token: "secret"}, false)
+	client.Logger = logger
+	if _, err := client.ShowUser(); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+
+	if len(logger.entries) != 1 {
+		t.Fatalf("expected 1 log entry, got %d", len(logger.entries))
+	}
+	e := logger.entries[0]
+	if e.msg != "response" || e.level != LevelInfo || e.fields["path"] != "/v2/user" || e.fields["status"] != 200 {
+		t.Errorf("unexpected log entry %+v", e)
+	}
+
+	logger.level = LevelDebug
+	logger.entries = nil
+	client.ShowUser()
+
+	var msgs []string
+	for _, e := range logger.entries {
+		msgs = append(msgs, e.msg)
+	}
+	if got := strings.Join(msgs, ","); got != "request,request headers,response,response body" {
+		t.Errorf("unexpected debug events %q", got)
+	}
+	if body := logger.entries[3].fields["body"]; body != `{"id":"u1"}` {
+		t.Errorf("expected response body to be logged, got %q", body)
+	}
+}
+
+func TestWriterLogger(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger := NewWriterLogger(buf, LevelInfo)
+	logger.Log(LevelDebug, "hidden")
+	logger.Log(LevelWarn, "response", LogField{"path", "/v2/user"}, LogField{"status", 429}, LogField{"msg", "a b"})
+
+	if got := buf.String(); !strings.HasSuffix(got, ` WARN response path=/v2/user status=429 msg="a b"`+"\n") || strings.Contains(got, "hidden") {
+		t.Errorf("unexpected output %q", got)
+	}
+}
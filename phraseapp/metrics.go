@@ -0,0 +1,237 @@
+package phraseapp
+
+import (
+	"errors"
+	"expvar"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RequestMetrics describe a finished API call. They are reported once the
+// response body is closed.
+type RequestMetrics struct {
+	Endpoint   string // client method, e.g. "LocaleDownload"
+	Method     string
+	Status     int // 0 if no response was received
+	Duration   time.Duration
+	Bytes      int64  // response body bytes read
+	ErrorClass string // empty on success, see ErrorClass
+	CacheHit   bool   // served from the HTTP cache after a 304
+
+	// RateLimitRemaining is the X-Rate-Limit-Remaining header of the
+	// response, or -1 if it was missing.
+	RateLimitRemaining int
+}
+
+// MetricsCollector records metrics of API calls. Implement it to bridge to
+// Prometheus style registries; ExpvarMetrics is a ready made collector.
+type MetricsCollector interface {
+	RecordRequest(m *RequestMetrics)
+}
+
+// MetricsFunc adapts a function to the MetricsCollector interface.
+type MetricsFunc func(m *RequestMetrics)
+
+func (f MetricsFunc) RecordRequest(m *RequestMetrics) {
+	f(m)
+}
+
+// Error classes reported in RequestMetrics.ErrorClass.
+const (
+	ErrorClassNetwork      = "network"
+	ErrorClassBadRequest   = "bad_request"
+	ErrorClassUnauthorized = "unauthorized"
+	ErrorClassForbidden    = "forbidden"
+	ErrorClassNotFound     = "not_found"
+	ErrorClassValidation   = "validation"
+	ErrorClassRateLimit    = "rate_limit"
+	ErrorClassServer       = "server"
+	ErrorClassOther        = "other"
+)
+
+// ErrorClass maps an error returned by the client to a metrics class. Errors
+// wrapped with fmt.Errorf("%w") or by middlewares are classed by the error
+// they wrap.
+func ErrorClass(err error) string {
+	var (
+		unauthorized ErrUnauthorized
+		forbidden    ErrForbidden
+		notFound     ErrNotFound
+		validation   *ValidationErrorResponse
+		rateLimit    *RateLimitingError
+		response     *ErrorResponse
+		server       ErrServer
+		urlErr       *url.Error
+		netErr       net.Error
+	)
+	switch {
+	case err == nil:
+		return ""
+	case errors.As(err, &unauthorized):
+		return ErrorClassUnauthorized
+	case errors.As(err, &forbidden):
+		return ErrorClassForbidden
+	case errors.As(err, &notFound):
+		return ErrorClassNotFound
+	case errors.As(err, &validation):
+		return ErrorClassValidation
+	case errors.As(err, &rateLimit):
+		return ErrorClassRateLimit
+	case errors.As(err, &response):
+		return ErrorClassBadRequest
+	case errors.As(err, &server):
+		return ErrorClassServer
+	case errors.As(err, &urlErr), errors.As(err, &netErr):
+		return ErrorClassNetwork
+	default:
+		return ErrorClassOther
+	}
+}
+
+// metricsBody reports the request metrics when the response body is closed.
+type metricsBody struct {
+	io.ReadCloser
+	metrics   *RequestMetrics
+	start     time.Time
+	collector MetricsCollector
+	once      sync.Once
+}
+
+func (b *metricsBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.metrics.Bytes += int64(n)
+	return n, err
+}
+
+func (b *metricsBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(func() {
+		b.metrics.Duration = time.Since(b.start)
+		b.collector.RecordRequest(b.metrics)
+	})
+	return err
+}
+
+func (client *Client) recordMetrics(endpoint string, req *http.Request, resp *http.Response, err error, start time.Time) {
+	if client.Metrics == nil {
+		return
+	}
+
+	m := &RequestMetrics{Endpoint: endpoint, Method: req.Method, RateLimitRemaining: -1}
+	if resp == nil {
+		m.ErrorClass = ErrorClass(err)
+		m.Duration = time.Since(start)
+		client.Metrics.RecordRequest(m)
+		return
+	}
+
+	m.Status = resp.StatusCode
+	if remaining, err := strconv.Atoi(resp.Header.Get("X-Rate-Limit-Remaining")); err == nil {
+		m.RateLimitRemaining = remaining
+	}
+	m.CacheHit = resp.Header.Get(cacheHeader) == "hit"
+	m.ErrorClass = ErrorClass(err)
+
+	resp.Body = &metricsBody{ReadCloser: resp.Body, metrics: m, start: start, collector: client.Metrics}
+}
+
+// latencyBuckets are the upper bounds of the latency histogram of
+// ExpvarMetrics.
+var latencyBuckets = []time.Duration{
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+	10 * time.Second,
+}
+
+// ExpvarMetrics publishes API call metrics as expvar variables below the
+// given prefix:
+//
+//	<prefix>.requests              calls per endpoint
+//	<prefix>.errors                calls per error class
+//	<prefix>.latency_ms            histogram per endpoint (le_<ms> buckets, le_inf)
+//	<prefix>.bytes                 response bytes per endpoint
+//	<prefix>.cache_hits            cached responses per endpoint
+//	<prefix>.rate_limit_remaining  last seen remaining rate limit
+type ExpvarMetrics struct {
+	Requests           *expvar.Map
+	Errors             *expvar.Map
+	Latency            *expvar.Map
+	Bytes              *expvar.Map
+	CacheHits          *expvar.Map
+	RateLimitRemaining *expvar.Int
+}
+
+// NewExpvarMetrics publishes the metric variables below prefix. Variables
+// already published by an earlier call with the same prefix are reused.
+func NewExpvarMetrics(prefix string) *ExpvarMetrics {
+	return &ExpvarMetrics{
+		Requests:           expvarMap(prefix + ".requests"),
+		Errors:             expvarMap(prefix + ".errors"),
+		Latency:            expvarMap(prefix + ".latency_ms"),
+		Bytes:              expvarMap(prefix + ".bytes"),
+		CacheHits:          expvarMap(prefix + ".cache_hits"),
+		RateLimitRemaining: expvarInt(prefix + ".rate_limit_remaining"),
+	}
+}
+
+var expvarMu sync.Mutex
+
+func expvarMap(name string) *expvar.Map {
+	expvarMu.Lock()
+	defer expvarMu.Unlock()
+	if m, ok := expvar.Get(name).(*expvar.Map); ok {
+		return m
+	}
+	return expvar.NewMap(name)
+}
+
+func expvarInt(name string) *expvar.Int {
+	expvarMu.Lock()
+	defer expvarMu.Unlock()
+	if i, ok := expvar.Get(name).(*expvar.Int); ok {
+		return i
+	}
+	return expvar.NewInt(name)
+}
+
+func (e *ExpvarMetrics) RecordRequest(m *RequestMetrics) {
+	e.Requests.Add(m.Endpoint, 1)
+	e.Bytes.Add(m.Endpoint, m.Bytes)
+	if m.ErrorClass != "" {
+		e.Errors.Add(m.ErrorClass, 1)
+	}
+	if m.CacheHit {
+		e.CacheHits.Add(m.Endpoint, 1)
+	}
+	if m.RateLimitRemaining >= 0 {
+		e.RateLimitRemaining.Set(int64(m.RateLimitRemaining))
+	}
+
+	histogram, ok := e.Latency.Get(m.Endpoint).(*expvar.Map)
+	if !ok {
+		expvarMu.Lock()
+		if histogram, ok = e.Latency.Get(m.Endpoint).(*expvar.Map); !ok {
+			histogram = new(expvar.Map).Init()
+			e.Latency.Set(m.Endpoint, histogram)
+		}
+		expvarMu.Unlock()
+	}
+	bucket := "le_inf"
+	for _, b := range latencyBuckets {
+		if m.Duration <= b {
+			bucket = "le_" + strconv.FormatInt(int64(b/time.Millisecond), 10)
+			break
+		}
+	}
+	histogram.Add(bucket, 1)
+}
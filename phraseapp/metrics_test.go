@@ -0,0 +1,96 @@
+package phraseapp
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Rate-Limit-Limit", "1000")
+		w.Header().Set("X-Rate-Limit-Remaining", "42")
+		w.Header().Set("X-Rate-Limit-Reset", "0")
+		switch r.URL.Path {
+		case "/v2/user":
+			io.WriteString(w, `{"id":"u1"}`)
+		case "/v2/projects/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case "/v2/projects":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			io.WriteString(w, `{"message":"invalid","errors":[]}`)
+		default:
+			w.WriteHeader(http.StatusTooManyRequests)
+		}
+	}))
+	defer server.Close()
+
+	var recorded []*RequestMetrics
+	expvarMetrics := NewExpvarMetrics("phraseapp_test")
+	client, _ := NewClient(Credentials{Host: server.URL, Token: "secret"}, false)
+	client.Metrics = MetricsFunc(func(m *RequestMetrics) {
+		recorded = append(recorded, m)
+		expvarMetrics.RecordRequest(m)
+	})
+
+	client.ShowUser()
+	client.ProjectShow("missing")
+	client.ProjectCreate(&ProjectParams{})
+	client.LocalesList("p1", 1, 10, &LocalesListParams{})
+
+	if len(recorded) != 4 {
+		t.Fatalf("expected 4 recorded calls, got %d", len(recorded))
+	}
+
+	exp := []struct {
+		endpoint, class string
+	}{
+		{"ShowUser", ""},
+		{"ProjectShow", ErrorClassNotFound},
+		{"ProjectCreate", ErrorClassValidation},
+		{"LocalesList", ErrorClassRateLimit},
+	}
+	for i, e := range exp {
+		if recorded[i].Endpoint != e.endpoint || recorded[i].ErrorClass != e.class {
+			t.Errorf("expected %s with error class %q, got %s with %q", e.endpoint, e.class, recorded[i].Endpoint, recorded[i].ErrorClass)
+		}
+	}
+
+	if recorded[0].Bytes != int64(len(`{"id":"u1"}`)) || recorded[0].RateLimitRemaining != 42 {
+		t.Errorf("unexpected metrics %+v", recorded[0])
+	}
+	if got := expvarMetrics.Requests.Get("ShowUser").String(); got != "1" {
+		t.Errorf("expected expvar request count 1, got %s", got)
+	}
+	if got := expvarMetrics.Errors.Get(ErrorClassRateLimit).String(); got != "1" {
+		t.Errorf("expected expvar rate limit count 1, got %s", got)
+	}
+	if got := expvarMetrics.RateLimitRemaining.Value(); got != 42 {
+		t.Errorf("expected rate limit remaining 42, got %d", got)
+	}
+}
+
+func TestErrorClass(t *testing.T) {
+	tests := []struct {
+		err error
+		exp string
+	}{
+		{nil, ""},
+		{&ErrorResponse{Message: "bad"}, ErrorClassBadRequest},
+		{fmt.Errorf("upload: %w", &ErrorResponse{Message: "bad"}), ErrorClassBadRequest},
+		{fmt.Errorf("retry: %w", &RateLimitingError{TooManyRequests: true}), ErrorClassRateLimit},
+		{fmt.Errorf("show: %w", &ValidationErrorResponse{}), ErrorClassValidation},
+		{fmt.Errorf("show: %w", ErrNotFound{}), ErrorClassNotFound},
+		{fmt.Errorf("dial: %w", &net.OpError{Op: "dial", Err: errors.New("refused")}), ErrorClassNetwork},
+		{errors.New("boom"), ErrorClassOther},
+	}
+	for _, tt := range tests {
+		if got := ErrorClass(tt.err); got != tt.exp {
+			t.Errorf("%v: expected class %q, got %q", tt.err, tt.exp, got)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package phraseapp
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RoundTripFunc performs a single HTTP request.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+// Middleware wraps a RoundTripFunc, e.g. to add headers, trace, retry or
+// mock requests. Middlewares see authenticated requests and run between the
+// client and its http.Client, for every API call.
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// Use appends middlewares to the client. The first middleware added is the
+// outermost one.
+func (client *Client) Use(middlewares ...Middleware) {
+	client.Middlewares = append(client.Middlewares, middlewares...)
+}
+
+func (client *Client) roundTrip(req *http.Request) (*http.Response, error) {
+	rt := RoundTripFunc(client.Client.Do)
+	for i := len(client.Middlewares) - 1; i >= 0; i-- {
+		rt = client.Middlewares[i](rt)
+	}
+	return rt(req)
+}
+
+type endpointKey struct{}
+
+// RequestEndpoint returns the name of the client method that sent the
+// request, e.g. "LocaleDownload".
+func RequestEndpoint(req *http.Request) string {
+	endpoint, _ := req.Context().Value(endpointKey{}).(string)
+	return endpoint
+}
+
+type attemptKey struct{}
+
+// RequestAttempt returns the attempt number of a request sent by
+// RetryMiddleware, starting at 1.
+func RequestAttempt(req *http.Request) int {
+	if attempt, ok := req.Context().Value(attemptKey{}).(int); ok {
+		return attempt
+	}
+	return 1
+}
+
+// LoggingMiddleware logs every round trip, including retried attempts.
+func LoggingMiddleware(logger Logger) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(req)
+			fields := []LogField{
+				{"method", req.Method},
+				{"path", req.URL.Path},
+				{"attempt", RequestAttempt(req)},
+				{"duration", time.Since(start)},
+			}
+			if err != nil {
+				logger.Log(LevelError, "round trip", append(fields, LogField{"error", err})...)
+			} else {
+				logger.Log(LevelDebug, "round trip", append(fields, LogField{"status", resp.StatusCode})...)
+			}
+			return resp, err
+		}
+	}
+}
+
+// RetryOptions configure RetryMiddleware.
+type RetryOptions struct {
+	MaxAttempts int           // including the first one, defaults to 3
+	Backoff     time.Duration // initial delay, doubled per attempt, defaults to 1s
+	MaxWait     time.Duration // upper bound of a single delay, defaults to 1m
+
+	// RetryNonIdempotent also retries POST and PATCH requests after server
+	// errors and network failures. Rate limited requests are always retried
+	// as they were not processed.
+	RetryNonIdempotent bool
+}
+
+var retrySleep = time.Sleep
+
+// RetryMiddleware retries requests that were rate limited (429), failed
+// with a server error (5xx) or a network error. Rate limited requests wait
+// until the rate limit resets.
+func RetryMiddleware(opts RetryOptions) Middleware {
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 3
+	}
+	if opts.Backoff <= 0 {
+		opts.Backoff = time.Second
+	}
+	if opts.MaxWait <= 0 {
+		opts.MaxWait = time.Minute
+	}
+
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			idempotent := opts.RetryNonIdempotent || (req.Method != "POST" && req.Method != "PATCH")
+			replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+			backoff := opts.Backoff
+
+			for attempt := 1; ; attempt++ {
+				try := req.WithContext(context.WithValue(req.Context(), attemptKey{}, attempt))
+				if attempt > 1 && req.GetBody != nil {
+					body, err := req.GetBody()
+					if err != nil {
+						return nil, err
+					}
+					try.Body = body
+				}
+
+				resp, err := next(try)
+				if attempt >= opts.MaxAttempts || !replayable {
+					return resp, err
+				}
+
+				var wait time.Duration
+				switch {
+				case err != nil && idempotent:
+					wait = backoff
+				case err != nil:
+					return resp, err
+				case resp.StatusCode == http.StatusTooManyRequests:
+					wait = rateLimitWait(resp, backoff)
+				case resp.StatusCode >= 500 && idempotent:
+					wait = backoff
+				default:
+					return resp, err
+				}
+
+				if resp != nil {
+					resp.Body.Close()
+				}
+				if wait > opts.MaxWait {
+					wait = opts.MaxWait
+				}
+				retrySleep(wait)
+				backoff *= 2
+			}
+		}
+	}
+}
+
+func rateLimitWait(resp *http.Response, fallback time.Duration) time.Duration {
+	if s, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+		return time.Duration(s) * time.Second
+	}
+	if reset, err := strconv.ParseInt(resp.Header.Get("X-Rate-Limit-Reset"), 10, 64); err == nil {
+		if wait := time.Until(time.Unix(reset, 0)); wait > 0 {
+			return wait
+		}
+		return 0
+	}
+	return fallback
+}
+
+// RequestStats describe a finished round trip.
+type RequestStats struct {
+	Method   string
+	Path     string
+	Status   int // 0 if the request failed without response
+	Duration time.Duration
+	Attempt  int
+	Err      error
+}
+
+// MetricsMiddleware calls record after every round trip.
+func MetricsMiddleware(record func(RequestStats)) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(req)
+			stats := RequestStats{
+				Method:   req.Method,
+				Path:     req.URL.Path,
+				Duration: time.Since(start),
+				Attempt:  RequestAttempt(req),
+				Err:      err,
+			}
+			if resp != nil {
+				stats.Status = resp.StatusCode
+			}
+			record(stats)
+			return resp, err
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package phraseapp
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMiddlewareOrderAndMocking(t *testing.T) {
+	var order []string
+	trace := func(name string) Middleware {
+		return func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				req.Header.Set("X-Trace", name)
+				return next(req)
+			}
+		}
+	}
+	mock := func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("Authorization") != "token secret" {
+				t.Errorf("expected middleware to see authenticated request")
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Header:     http.Header{},
+				Body:       ioutil.NopCloser(strings.NewReader(`{"id":"mocked"}`)),
+			}, nil
+		}
+	}
+
+	client, _ := NewClient(Credentials{Host: "http://localhost:0", Token: "secret"}, false)
+	client.Use(trace("outer"), trace("inner"), mock)
+
+	user, err := client.ShowUser()
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if user.ID != "mocked" {
+		t.Errorf("expected mocked user, got %q", user.ID)
+	}
+	if got := strings.Join(order, ","); got != "outer,inner" {
+		t.Errorf("expected middlewares to run in order, got %q", got)
+	}
+}
+
+func TestRetryMiddleware(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if len(bodies) < 3 {
+			w.Header().Set("Retry-After", "2")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		io.WriteString(w, `{"id":"t1"}`)
+	}))
+	defer server.Close()
+
+	var waits []time.Duration
+	retrySleep = func(d time.Duration) { waits = append(waits, d) }
+	defer func() { retrySleep = time.Sleep }()
+
+	var attempts []int
+	client, _ := NewClient(Credentials{Host: server.URL, Token: "secret"}, false)
+	client.Use(RetryMiddleware(RetryOptions{MaxAttempts: 5}), MetricsMiddleware(func(s RequestStats) {
+		attempts = append(attempts, s.Attempt)
+	}))
+
+	name := "release"
+	if _, err := client.TagCreate("p1", &TagParams{Name: &name}); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+
+	if len(bodies) != 3 || bodies[0] != bodies[2] || !strings.Contains(bodies[2], "release") {
+		t.Errorf("expected the body to be resent, got %q", bodies)
+	}
+	if len(waits) != 2 || waits[0] != 2*time.Second {
+		t.Errorf("expected to wait for Retry-After, got %v", waits)
+	}
+	if len(attempts) != 3 || attempts[2] != 3 {
+		t.Errorf("expected attempts to be counted, got %v", attempts)
+	}
+}
@@ -0,0 +1,236 @@
+package phraseapp
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// paramsCommands maps the commands of defaults blocks in config files to the
+// params of their API call, e.g. "locale/download" to LocaleDownloadParams.
+// Commands are named after the Client methods.
+var paramsCommands = map[string]reflect.Type{
+	"authorization/create":             reflect.TypeOf(AuthorizationParams{}),
+	"authorization/update":             reflect.TypeOf(AuthorizationParams{}),
+	"bitbucket_sync/export":            reflect.TypeOf(BitbucketSyncParams{}),
+	"bitbucket_sync/import":            reflect.TypeOf(BitbucketSyncParams{}),
+	"bitbucket_syncs/list":             reflect.TypeOf(BitbucketSyncParams{}),
+	"blacklisted_key/create":           reflect.TypeOf(BlacklistedKeyParams{}),
+	"blacklisted_key/update":           reflect.TypeOf(BlacklistedKeyParams{}),
+	"branch/compare":                   reflect.TypeOf(BranchParams{}),
+	"branch/create":                    reflect.TypeOf(BranchParams{}),
+	"branch/merge":                     reflect.TypeOf(BranchMergeParams{}),
+	"branch/update":                    reflect.TypeOf(BranchParams{}),
+	"comment/create":                   reflect.TypeOf(CommentParams{}),
+	"comment/delete":                   reflect.TypeOf(CommentDeleteParams{}),
+	"comment/mark_check":               reflect.TypeOf(CommentMarkCheckParams{}),
+	"comment/mark_read":                reflect.TypeOf(CommentMarkReadParams{}),
+	"comment/mark_unread":              reflect.TypeOf(CommentMarkUnreadParams{}),
+	"comment/show":                     reflect.TypeOf(CommentShowParams{}),
+	"comment/update":                   reflect.TypeOf(CommentParams{}),
+	"comments/list":                    reflect.TypeOf(CommentsListParams{}),
+	"distribution/create":              reflect.TypeOf(DistributionsParams{}),
+	"distribution/update":              reflect.TypeOf(DistributionsParams{}),
+	"glossary/create":                  reflect.TypeOf(GlossaryParams{}),
+	"glossary/update":                  reflect.TypeOf(GlossaryParams{}),
+	"glossary_term/create":             reflect.TypeOf(GlossaryTermParams{}),
+	"glossary_term/update":             reflect.TypeOf(GlossaryTermParams{}),
+	"glossary_term_translation/create": reflect.TypeOf(GlossaryTermTranslationParams{}),
+	"glossary_term_translation/update": reflect.TypeOf(GlossaryTermTranslationParams{}),
+	"invitation/create":                reflect.TypeOf(InvitationCreateParams{}),
+	"invitation/update":                reflect.TypeOf(InvitationUpdateParams{}),
+	"job/complete":                     reflect.TypeOf(JobCompleteParams{}),
+	"job/create":                       reflect.TypeOf(JobParams{}),
+	"job/delete":                       reflect.TypeOf(JobDeleteParams{}),
+	"job/reopen":                       reflect.TypeOf(JobReopenParams{}),
+	"job/show":                         reflect.TypeOf(JobShowParams{}),
+	"job/start":                        reflect.TypeOf(JobStartParams{}),
+	"job/update":                       reflect.TypeOf(JobUpdateParams{}),
+	"job_keys/create":                  reflect.TypeOf(JobKeysCreateParams{}),
+	"job_keys/delete":                  reflect.TypeOf(JobKeysDeleteParams{}),
+	"job_locale/complete":              reflect.TypeOf(JobLocaleCompleteParams{}),
+	"job_locale/delete":                reflect.TypeOf(JobLocaleDeleteParams{}),
+	"job_locale/reopen":                reflect.TypeOf(JobLocaleReopenParams{}),
+	"job_locale/show":                  reflect.TypeOf(JobLocaleShowParams{}),
+	"job_locale/update":                reflect.TypeOf(JobLocaleParams{}),
+	"job_locales/create":               reflect.TypeOf(JobLocaleParams{}),
+	"job_locales/list":                 reflect.TypeOf(JobLocalesListParams{}),
+	"jobs/list":                        reflect.TypeOf(JobsListParams{}),
+	"key/create":                       reflect.TypeOf(TranslationKeyParams{}),
+	"key/delete":                       reflect.TypeOf(KeyDeleteParams{}),
+	"key/show":                         reflect.TypeOf(KeyShowParams{}),
+	"key/update":                       reflect.TypeOf(TranslationKeyParams{}),
+	"keys/delete":                      reflect.TypeOf(KeysDeleteParams{}),
+	"keys/list":                        reflect.TypeOf(KeysListParams{}),
+	"keys/search":                      reflect.TypeOf(KeysSearchParams{}),
+	"keys/tag":                         reflect.TypeOf(KeysTagParams{}),
+	"keys/untag":                       reflect.TypeOf(KeysUntagParams{}),
+	"locale/create":                    reflect.TypeOf(LocaleParams{}),
+	"locale/delete":                    reflect.TypeOf(LocaleDeleteParams{}),
+	"locale/download":                  reflect.TypeOf(LocaleDownloadParams{}),
+	"locale/show":                      reflect.TypeOf(LocaleShowParams{}),
+	"locale/update":                    reflect.TypeOf(LocaleParams{}),
+	"locales/list":                     reflect.TypeOf(LocalesListParams{}),
+	"member/update":                    reflect.TypeOf(MemberUpdateParams{}),
+	"order/confirm":                    reflect.TypeOf(OrderConfirmParams{}),
+	"order/create":                     reflect.TypeOf(TranslationOrderParams{}),
+	"order/delete":                     reflect.TypeOf(OrderDeleteParams{}),
+	"order/show":                       reflect.TypeOf(OrderShowParams{}),
+	"orders/list":                      reflect.TypeOf(OrdersListParams{}),
+	"project/create":                   reflect.TypeOf(ProjectParams{}),
+	"project/update":                   reflect.TypeOf(ProjectParams{}),
+	"release/create":                   reflect.TypeOf(ReleasesParams{}),
+	"release/update":                   reflect.TypeOf(ReleasesParams{}),
+	"screenshot/create":                reflect.TypeOf(ScreenshotParams{}),
+	"screenshot/update":                reflect.TypeOf(ScreenshotParams{}),
+	"screenshot_marker/create":         reflect.TypeOf(ScreenshotMarkerParams{}),
+	"screenshot_marker/update":         reflect.TypeOf(ScreenshotMarkerParams{}),
+	"space/create":                     reflect.TypeOf(SpaceCreateParams{}),
+	"space/update":                     reflect.TypeOf(SpaceUpdateParams{}),
+	"spaces_projects/create":           reflect.TypeOf(SpacesProjectsCreateParams{}),
+	"styleguide/create":                reflect.TypeOf(StyleguideParams{}),
+	"styleguide/update":                reflect.TypeOf(StyleguideParams{}),
+	"tag/create":                       reflect.TypeOf(TagParams{}),
+	"tag/delete":                       reflect.TypeOf(TagDeleteParams{}),
+	"tag/show":                         reflect.TypeOf(TagShowParams{}),
+	"tags/list":                        reflect.TypeOf(TagsListParams{}),
+	"translation/create":               reflect.TypeOf(TranslationParams{}),
+	"translation/exclude":              reflect.TypeOf(TranslationExcludeParams{}),
+	"translation/include":              reflect.TypeOf(TranslationIncludeParams{}),
+	"translation/review":               reflect.TypeOf(TranslationReviewParams{}),
+	"translation/show":                 reflect.TypeOf(TranslationShowParams{}),
+	"translation/unverify":             reflect.TypeOf(TranslationUnverifyParams{}),
+	"translation/update":               reflect.TypeOf(TranslationUpdateParams{}),
+	"translation/verify":               reflect.TypeOf(TranslationVerifyParams{}),
+	"translations/by_key":              reflect.TypeOf(TranslationsByKeyParams{}),
+	"translations/by_locale":           reflect.TypeOf(TranslationsByLocaleParams{}),
+	"translations/exclude":             reflect.TypeOf(TranslationsExcludeParams{}),
+	"translations/include":             reflect.TypeOf(TranslationsIncludeParams{}),
+	"translations/list":                reflect.TypeOf(TranslationsListParams{}),
+	"translations/review":              reflect.TypeOf(TranslationsReviewParams{}),
+	"translations/search":              reflect.TypeOf(TranslationsSearchParams{}),
+	"translations/unverify":            reflect.TypeOf(TranslationsUnverifyParams{}),
+	"translations/verify":              reflect.TypeOf(TranslationsVerifyParams{}),
+	"upload/create":                    reflect.TypeOf(UploadParams{}),
+	"upload/show":                      reflect.TypeOf(UploadShowParams{}),
+	"uploads/list":                     reflect.TypeOf(UploadsListParams{}),
+	"version/show":                     reflect.TypeOf(VersionShowParams{}),
+	"versions/list":                    reflect.TypeOf(VersionsListParams{}),
+	"webhook/create":                   reflect.TypeOf(WebhookParams{}),
+	"webhook/update":                   reflect.TypeOf(WebhookParams{}),
+}
+
+type paramsApplier interface {
+	ApplyValuesFromMap(map[string]interface{}) error
+}
+
+// ParamsCommands returns the commands of defaults blocks in config files,
+// sorted, e.g. "locale/download" and "upload/create".
+func ParamsCommands() []string {
+	commands := make([]string, 0, len(paramsCommands))
+	for command := range paramsCommands {
+		commands = append(commands, command)
+	}
+	sort.Strings(commands)
+	return commands
+}
+
+// NewParams returns new params for the command, e.g. *LocaleDownloadParams
+// for "locale/download", or nil for unknown commands.
+func NewParams(command string) interface{} {
+	typ, found := paramsCommands[command]
+	if !found {
+		return nil
+	}
+	return reflect.New(typ).Interface()
+}
+
+// DefaultParams returns new params for the command with the defaults of the
+// config applied, see ApplyDefaults.
+func (cfg *Config) DefaultParams(command string) (interface{}, error) {
+	params := NewParams(command)
+	if params == nil {
+		return nil, fmt.Errorf("unknown command %q", command)
+	}
+	return params, cfg.ApplyDefaults(command, params)
+}
+
+// ApplyDefaults sets the fields of params not set yet to the defaults of the
+// command in the config, so values set by the caller take precedence:
+//
+//	params := &LocaleDownloadParams{Encoding: &encoding}
+//	err := cfg.ApplyDefaults("locale/download", params)
+//
+// params must be of the command's type, see NewParams.
+func (cfg *Config) ApplyDefaults(command string, params interface{}) error {
+	typ, found := paramsCommands[command]
+	if !found {
+		return fmt.Errorf("unknown command %q", command)
+	}
+	v := reflect.ValueOf(params)
+	if v.Kind() != reflect.Ptr || v.IsNil() || v.Elem().Type() != typ {
+		return fmt.Errorf("expected *%s for command %q, got %T", typ.Name(), command, params)
+	}
+
+	defaults := cfg.Defaults[command]
+	if len(defaults) == 0 {
+		return nil
+	}
+
+	applied := reflect.New(typ)
+	if err := applied.Interface().(paramsApplier).ApplyValuesFromMap(coerceDefaults(typ, defaults)); err != nil {
+		return fmt.Errorf("defaults.%s: %s", command, err)
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		if field := v.Elem().Field(i); field.IsZero() {
+			field.Set(applied.Elem().Field(i))
+		}
+	}
+	return nil
+}
+
+// coerceDefaults converts values as decoded from YAML to the types
+// ApplyValuesFromMap expects for the fields of typ: int64 numbers, string
+// slices and times.
+func coerceDefaults(typ reflect.Type, defaults map[string]interface{}) map[string]interface{} {
+	coerced := make(map[string]interface{}, len(defaults))
+	for key, value := range defaults {
+		coerced[key] = value
+		field, found := paramsField(typ, key)
+		if !found {
+			continue
+		}
+
+		switch v := value.(type) {
+		case int:
+			if field.Type == reflect.TypeOf((*int64)(nil)) {
+				coerced[key] = int64(v)
+			}
+		case []interface{}:
+			if field.Type.Kind() != reflect.Slice || field.Type.Elem().Kind() != reflect.String {
+				continue
+			}
+			strs := make([]string, 0, len(v))
+			for _, item := range v {
+				if s, ok := item.(string); ok {
+					strs = append(strs, s)
+				}
+			}
+			if len(strs) == len(v) {
+				coerced[key] = strs
+			}
+		case string:
+			if field.Type == reflect.TypeOf((**time.Time)(nil)) {
+				if t, err := time.Parse(time.RFC3339, v); err == nil {
+					coerced[key] = &t
+				}
+			}
+		case time.Time:
+			if field.Type == reflect.TypeOf((**time.Time)(nil)) {
+				coerced[key] = &v
+			}
+		}
+	}
+	return coerced
+}
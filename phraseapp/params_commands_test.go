@@ -0,0 +1,72 @@
+package phraseapp
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestApplyDefaults(t *testing.T) {
+	cfg, err := configFromValues(map[string]interface{}{
+		"defaults": map[string]interface{}{
+			"locale/download": map[string]interface{}{
+				"file_format":    "yml",
+				"encoding":       "UTF-8",
+				"format_options": map[string]interface{}{"indent": "4"},
+			},
+			"key/create": map[string]interface{}{
+				"max_characters_allowed": 80,
+				"plural":                 true,
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+
+	encoding := "UTF-16"
+	download := &LocaleDownloadParams{Encoding: &encoding}
+	if err := cfg.ApplyDefaults("locale/download", download); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if *download.Encoding != "UTF-16" || download.FileFormat == nil || *download.FileFormat != "yml" {
+		t.Errorf("expected explicit values to take precedence over defaults, got %+v", download)
+	}
+	if exp := map[string]string{"indent": "4"}; !reflect.DeepEqual(download.FormatOptions, exp) {
+		t.Errorf("expected format options %v, got %v", exp, download.FormatOptions)
+	}
+
+	params, err := cfg.DefaultParams("key/create")
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	key, ok := params.(*TranslationKeyParams)
+	if !ok || key.MaxCharactersAllowed == nil || *key.MaxCharactersAllowed != 80 || key.Plural == nil || !*key.Plural {
+		t.Errorf("expected key params with defaults, got %#v", params)
+	}
+
+	if params, err := cfg.DefaultParams("upload/create"); err != nil || !reflect.DeepEqual(params, &UploadParams{}) {
+		t.Errorf("expected empty upload params, got %#v (%v)", params, err)
+	}
+	if err := cfg.ApplyDefaults("locale/download", &TranslationKeyParams{}); err == nil || !strings.Contains(err.Error(), "expected *LocaleDownloadParams") {
+		t.Errorf("expected a type error, got %v", err)
+	}
+	if _, err := cfg.DefaultParams("locale/upload"); err == nil || err.Error() != `unknown command "locale/upload"` {
+		t.Errorf("expected an unknown command error, got %v", err)
+	}
+}
+
+func TestParamsCommands(t *testing.T) {
+	commands := ParamsCommands()
+	if len(commands) != len(paramsCommands) {
+		t.Errorf("expected %d commands, got %d", len(paramsCommands), len(commands))
+	}
+	for _, command := range commands {
+		if NewParams(command) == nil {
+			t.Errorf("%s: expected params", command)
+		}
+	}
+	if NewParams("locale/upload") != nil {
+		t.Errorf("expected no params of unknown commands")
+	}
+}
@@ -0,0 +1,623 @@
+package phraseapp
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// paramsRules are the validation rules of the API spec per params type and
+// field. The Validate methods of the params types check them as for
+// updates, i.e. fields only required on create are optional; params without
+// rules are valid. Params with fields required on create also have a
+// ValidateCreate method. The client checks them before calls if its
+// ValidateParams is set, depending on the endpoint. Rules are comma
+// separated checks:
+//
+//	required     the field must be set
+//	create       the field must be set when creating a resource
+//	enum=a|b     the value must be one of the listed ones
+//	locale_code  the value must look like a locale code, e.g. "en-GB"
+//	format       the value must be the API name of a file format
+//	email, url   the value must be an email address or an http(s) URL
+//	future       the time must be in the future
+var paramsRules = map[reflect.Type]map[string]string{
+	reflect.TypeOf(AuthorizationParams{}):           {"Note": "create", "ExpiresAt": "future"},
+	reflect.TypeOf(BlacklistedKeyParams{}):          {"Name": "create"},
+	reflect.TypeOf(BranchParams{}):                  {"Name": "create"},
+	reflect.TypeOf(BranchMergeParams{}):             {"Strategy": "enum=use_main|use_branch"},
+	reflect.TypeOf(CommentParams{}):                 {"Message": "required"},
+	reflect.TypeOf(DistributionsParams{}):           {"Name": "create", "ProjectID": "create", "Platforms": "create"},
+	reflect.TypeOf(GlossaryParams{}):                {"Name": "create"},
+	reflect.TypeOf(GlossaryTermParams{}):            {"Term": "create"},
+	reflect.TypeOf(GlossaryTermTranslationParams{}): {"LocaleCode": "create,locale_code", "Content": "create"},
+	reflect.TypeOf(InvitationCreateParams{}):        {"Email": "required,email", "Role": "required,enum=Owner|Admin|Manager|Developer|Designer|Translator"},
+	reflect.TypeOf(InvitationUpdateParams{}):        {"Role": "enum=Owner|Admin|Manager|Developer|Designer|Translator"},
+	reflect.TypeOf(JobParams{}):                     {"Name": "create", "DueDate": "future"},
+	reflect.TypeOf(JobUpdateParams{}):               {"DueDate": "future"},
+	reflect.TypeOf(JobLocaleParams{}):               {"LocaleID": "create"},
+	reflect.TypeOf(KeysListParams{}):                {"Sort": "enum=name|created_at|updated_at", "Order": "enum=asc|desc"},
+	reflect.TypeOf(KeysSearchParams{}):              {"Sort": "enum=name|created_at|updated_at", "Order": "enum=asc|desc"},
+	reflect.TypeOf(KeysTagParams{}):                 {"Tags": "required"},
+	reflect.TypeOf(KeysUntagParams{}):               {"Tags": "required"},
+	reflect.TypeOf(LocaleParams{}):                  {"Name": "create", "Code": "create,locale_code"},
+	reflect.TypeOf(LocaleDownloadParams{}):          {"FileFormat": "required,format"},
+	reflect.TypeOf(MemberUpdateParams{}):            {"Role": "enum=Owner|Admin|Manager|Developer|Designer|Translator"},
+	reflect.TypeOf(ProjectParams{}):                 {"Name": "create", "MainFormat": "format"},
+	reflect.TypeOf(ScreenshotMarkerParams{}):        {"KeyID": "create"},
+	reflect.TypeOf(ScreenshotParams{}):              {"Filename": "create"},
+	reflect.TypeOf(SpaceCreateParams{}):             {"Name": "required"},
+	reflect.TypeOf(StyleguideParams{}):              {"Title": "create"},
+	reflect.TypeOf(TagParams{}):                     {"Name": "required"},
+	reflect.TypeOf(TranslationKeyParams{}):          {"Name": "create", "DataType": "enum=string|number|boolean|array|markdown"},
+	reflect.TypeOf(TranslationOrderParams{}):        {"Lsp": "create,enum=gengo|textmaster", "SourceLocaleID": "create", "TargetLocaleIDs": "create", "TranslationType": "create"},
+	reflect.TypeOf(TranslationParams{}):             {"KeyID": "create", "LocaleID": "create", "Content": "create"},
+	reflect.TypeOf(TranslationsByKeyParams{}):       {"Sort": "enum=updated_at", "Order": "enum=asc|desc"},
+	reflect.TypeOf(TranslationsByLocaleParams{}):    {"Sort": "enum=updated_at", "Order": "enum=asc|desc"},
+	reflect.TypeOf(TranslationsListParams{}):        {"Sort": "enum=updated_at", "Order": "enum=asc|desc"},
+	reflect.TypeOf(TranslationsExcludeParams{}):     {"Sort": "enum=updated_at", "Order": "enum=asc|desc"},
+	reflect.TypeOf(TranslationsIncludeParams{}):     {"Sort": "enum=updated_at", "Order": "enum=asc|desc"},
+	reflect.TypeOf(TranslationsSearchParams{}):      {"Sort": "enum=updated_at", "Order": "enum=asc|desc"},
+	reflect.TypeOf(TranslationsUnverifyParams{}):    {"Sort": "enum=updated_at", "Order": "enum=asc|desc"},
+	reflect.TypeOf(TranslationsVerifyParams{}):      {"Sort": "enum=updated_at", "Order": "enum=asc|desc"},
+	reflect.TypeOf(UploadParams{}):                  {"File": "required", "FileFormat": "format"},
+	reflect.TypeOf(WebhookParams{}):                 {"CallbackUrl": "create,url", "Events": "create"},
+}
+
+var (
+	localeCodePattern = regexp.MustCompile(`^[a-zA-Z]{2,3}([-_][a-zA-Z0-9]{1,8})*$`)
+	formatNamePattern = regexp.MustCompile(`^[a-z0-9_]+$`)
+)
+
+// validateParams checks params against paramsRules. Fields only required
+// on create are skipped unless create is set. Format names are checked
+// against those returned by formats if given and not empty, else only for
+// their syntax; formats is only called if there's a format to check. The
+// returned error is a *ValidationErrorResponse like the API's.
+func validateParams(params interface{}, create bool, formats func() []string) error {
+	v := reflect.ValueOf(params)
+	if v.Kind() != reflect.Ptr {
+		return nil
+	}
+	t := v.Type().Elem()
+	rules := paramsRules[t]
+	if len(rules) == 0 {
+		return nil
+	}
+	if v.IsNil() {
+		v = reflect.New(t)
+	}
+	v = v.Elem()
+
+	verr := &ValidationErrorResponse{ErrorResponse: ErrorResponse{Message: "Invalid params"}, Params: params}
+	fields := make([]string, 0, len(rules))
+	for name := range rules {
+		fields = append(fields, name)
+	}
+	sort.Strings(fields)
+
+	for _, name := range fields {
+		f, ok := t.FieldByName(name)
+		if !ok {
+			continue
+		}
+		if msg := checkField(v.FieldByIndex(f.Index), rules[name], create, formats); msg != "" {
+			verr.Errors = append(verr.Errors, ValidationErrorMessage{
+				Resource: strings.TrimSuffix(t.Name(), "Params"),
+				Field:    strings.Split(f.Tag.Get("json"), ",")[0],
+				Message:  msg,
+				GoField:  name,
+			})
+		}
+	}
+
+	if len(verr.Errors) > 0 {
+		return verr
+	}
+	return nil
+}
+
+// checkField returns the validation message for a field value, or "".
+func checkField(v reflect.Value, rule string, create bool, formats func() []string) string {
+	for v.Kind() == reflect.Ptr && !v.IsNil() {
+		v = v.Elem()
+	}
+	set := v.Kind() != reflect.Ptr && !v.IsZero()
+	if v.Kind() == reflect.Slice || v.Kind() == reflect.Map {
+		set = v.Len() > 0
+	}
+
+	for _, check := range strings.Split(rule, ",") {
+		switch {
+		case check == "required" || check == "create":
+			if !set && (check == "required" || create) {
+				return "can't be blank"
+			}
+		case !set:
+			continue
+		case strings.HasPrefix(check, "enum="):
+			if !contains(strings.Split(check[len("enum="):], "|"), v.String()) {
+				return fmt.Sprintf("is not included in the list (%s)", strings.Replace(check[len("enum="):], "|", ", ", -1))
+			}
+		case check == "locale_code":
+			if !localeCodePattern.MatchString(v.String()) {
+				return "is not a valid locale code"
+			}
+		case check == "format":
+			if !formatNamePattern.MatchString(v.String()) {
+				return "is not a known file format"
+			}
+			if formats == nil {
+				continue
+			}
+			if names := formats(); len(names) > 0 && !contains(names, v.String()) {
+				return "is not a known file format"
+			}
+		case check == "email":
+			if _, err := mail.ParseAddress(v.String()); err != nil {
+				return "is not a valid email address"
+			}
+		case check == "url":
+			if u, err := url.Parse(v.String()); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return "is not a valid URL"
+			}
+		case check == "future":
+			if t, ok := v.Interface().(time.Time); ok && !t.After(time.Now()) {
+				return "must be in the future"
+			}
+		}
+	}
+	return ""
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func (params *AuthorizationParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *BitbucketSyncParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *BlacklistedKeyParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *BranchParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *CommentParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *DistributionsParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *GlossaryParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *GlossaryTermTranslationParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *GlossaryTermParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *JobLocaleParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *JobParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TranslationKeyParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *LocaleParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TranslationOrderParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *ProjectParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *ReleasesParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *ScreenshotMarkerParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *ScreenshotParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *SpaceParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *StyleguideParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TagParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TranslationParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *UploadParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *WebhookParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *BranchMergeParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *CommentDeleteParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *CommentMarkCheckParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *CommentMarkReadParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *CommentMarkUnreadParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *CommentShowParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *CommentsListParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *InvitationCreateParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *InvitationUpdateParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *JobCompleteParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *JobDeleteParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *JobKeysCreateParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *JobKeysDeleteParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *JobReopenParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *JobShowParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *JobStartParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *JobUpdateParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *JobLocaleCompleteParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *JobLocaleDeleteParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *JobLocaleReopenParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *JobLocaleShowParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *JobLocalesListParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *JobsListParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *KeyDeleteParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *KeyShowParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *KeysDeleteParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *KeysListParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *KeysSearchParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *KeysTagParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *KeysUntagParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *LocaleDeleteParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *LocaleDownloadParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *LocaleShowParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *LocalesListParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *MemberUpdateParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *OrderConfirmParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *OrderDeleteParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *OrderShowParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *OrdersListParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *SpaceCreateParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *SpaceUpdateParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *SpacesProjectsCreateParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TagDeleteParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TagShowParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TagsListParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TranslationExcludeParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TranslationIncludeParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TranslationReviewParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TranslationShowParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TranslationUnverifyParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TranslationUpdateParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TranslationVerifyParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TranslationsByKeyParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TranslationsByLocaleParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TranslationsExcludeParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TranslationsIncludeParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TranslationsListParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TranslationsReviewParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TranslationsSearchParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TranslationsUnverifyParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *TranslationsVerifyParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *UploadShowParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *UploadsListParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *VersionShowParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *VersionsListParams) Validate() error {
+	return validateParams(params, false, nil)
+}
+
+func (params *AuthorizationParams) ValidateCreate() error {
+	return validateParams(params, true, nil)
+}
+
+func (params *BlacklistedKeyParams) ValidateCreate() error {
+	return validateParams(params, true, nil)
+}
+
+func (params *BranchParams) ValidateCreate() error {
+	return validateParams(params, true, nil)
+}
+
+func (params *DistributionsParams) ValidateCreate() error {
+	return validateParams(params, true, nil)
+}
+
+func (params *GlossaryParams) ValidateCreate() error {
+	return validateParams(params, true, nil)
+}
+
+func (params *GlossaryTermParams) ValidateCreate() error {
+	return validateParams(params, true, nil)
+}
+
+func (params *GlossaryTermTranslationParams) ValidateCreate() error {
+	return validateParams(params, true, nil)
+}
+
+func (params *JobLocaleParams) ValidateCreate() error {
+	return validateParams(params, true, nil)
+}
+
+func (params *JobParams) ValidateCreate() error {
+	return validateParams(params, true, nil)
+}
+
+func (params *LocaleParams) ValidateCreate() error {
+	return validateParams(params, true, nil)
+}
+
+func (params *ProjectParams) ValidateCreate() error {
+	return validateParams(params, true, nil)
+}
+
+func (params *ScreenshotMarkerParams) ValidateCreate() error {
+	return validateParams(params, true, nil)
+}
+
+func (params *ScreenshotParams) ValidateCreate() error {
+	return validateParams(params, true, nil)
+}
+
+func (params *StyleguideParams) ValidateCreate() error {
+	return validateParams(params, true, nil)
+}
+
+func (params *TranslationKeyParams) ValidateCreate() error {
+	return validateParams(params, true, nil)
+}
+
+func (params *TranslationOrderParams) ValidateCreate() error {
+	return validateParams(params, true, nil)
+}
+
+func (params *TranslationParams) ValidateCreate() error {
+	return validateParams(params, true, nil)
+}
+
+func (params *WebhookParams) ValidateCreate() error {
+	return validateParams(params, true, nil)
+}
+
+// validate checks params before a call if the client's ValidateParams is
+// set. File formats are checked against those of the client's Formats,
+// fetched only when there's a format to check.
+func (client *Client) validate(params interface{}, create bool) error {
+	if !client.ValidateParams {
+		return nil
+	}
+	return validateParams(params, create, client.formatRegistry().validationFormats)
+}
@@ -0,0 +1,164 @@
+package phraseapp
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func str(s string) *string { return &s }
+
+func fileFormat(f FileFormat) *FileFormat { return &f }
+
+func TestParamsValidate(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+	pastPtr := &past
+
+	tests := []struct {
+		name   string
+		params interface{ Validate() error }
+		fields []string
+	}{
+		{"valid locale", &LocaleParams{Name: str("English"), Code: str("en-GB")}, nil},
+		{"partial update", &TranslationKeyParams{Description: str("Greeting")}, nil},
+		{"invalid locale code", &LocaleParams{Name: str("English"), Code: str("english!")}, []string{"Code"}},
+		{"nil params", (*TranslationParams)(nil), nil},
+		{"missing file", &UploadParams{FileFormat: fileFormat("yml")}, []string{"File"}},
+		{"invalid format", &UploadParams{File: str("en.yml"), FileFormat: fileFormat("YAML 1.2")}, []string{"FileFormat"}},
+		{"enum", &KeysListParams{Sort: str("name"), Order: str("up")}, []string{"Order"}},
+		{"past due date", &JobParams{Name: str("Job"), DueDate: &pastPtr}, []string{"DueDate"}},
+		{"invalid email", &InvitationCreateParams{Email: str("nobody"), Role: str("Admin")}, []string{"Email"}},
+		{"no rules", &LocalesListParams{}, nil},
+	}
+	for _, tt := range tests {
+		err := tt.params.Validate()
+		if len(tt.fields) == 0 {
+			if err != nil {
+				t.Errorf("%s: didn't expect an error, got %q", tt.name, err)
+			}
+			continue
+		}
+		verr, ok := err.(*ValidationErrorResponse)
+		if !ok {
+			t.Errorf("%s: expected a validation error, got %v", tt.name, err)
+			continue
+		}
+		if len(verr.Errors) != len(tt.fields) {
+			t.Errorf("%s: expected errors for %v, got %q", tt.name, tt.fields, verr)
+			continue
+		}
+		for i, field := range tt.fields {
+			if verr.Errors[i].GoField != field {
+				t.Errorf("%s: expected an error for %s, got %s", tt.name, field, verr.Errors[i].GoField)
+			}
+		}
+	}
+}
+
+func TestParamsValidateCreate(t *testing.T) {
+	tests := []struct {
+		name   string
+		params interface{ ValidateCreate() error }
+		fields []string
+	}{
+		{"valid locale", &LocaleParams{Name: str("English"), Code: str("en-GB")}, nil},
+		{"missing locale name", &LocaleParams{Code: str("en")}, []string{"Name"}},
+		{"nil params", (*TranslationParams)(nil), []string{"Content", "KeyID", "LocaleID"}},
+	}
+	for _, tt := range tests {
+		err := tt.params.ValidateCreate()
+		if len(tt.fields) == 0 {
+			if err != nil {
+				t.Errorf("%s: didn't expect an error, got %q", tt.name, err)
+			}
+			continue
+		}
+		verr, ok := err.(*ValidationErrorResponse)
+		if !ok || len(verr.Errors) != len(tt.fields) {
+			t.Errorf("%s: expected errors for %v, got %v", tt.name, tt.fields, err)
+			continue
+		}
+		for i, field := range tt.fields {
+			if verr.Errors[i].GoField != field {
+				t.Errorf("%s: expected an error for %s, got %s", tt.name, field, verr.Errors[i].GoField)
+			}
+		}
+	}
+}
+
+func TestClientValidateParams(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path == "/v2/formats" {
+			io.WriteString(w, `[{"api_name":"yml"},{"api_name":"json"}]`)
+			return
+		}
+		if r.Method == "POST" {
+			w.WriteHeader(http.StatusCreated)
+		}
+		io.WriteString(w, `{}`)
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Credentials{Host: server.URL, Token: "secret"}, false)
+	if _, err := client.LocaleCreate("p1", &LocaleParams{}); err != nil {
+		t.Fatalf("didn't expect an error without ValidateParams, got %q", err)
+	}
+	if requests != 1 {
+		t.Fatalf("expected the request to be sent, got %d requests", requests)
+	}
+
+	client.ValidateParams = true
+	_, err := client.LocaleCreate("p1", &LocaleParams{Code: str("en")})
+	if verr, ok := err.(*ValidationErrorResponse); !ok || len(verr.ForField("Name")) != 1 {
+		t.Errorf("expected a validation error for Name, got %v", err)
+	}
+	if _, err := client.LocaleUpdate("p1", "en", &LocaleParams{Code: str("en")}); err != nil {
+		t.Errorf("expected the name to be optional on update, got %q", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected no formats to be fetched without a format to check, got %d requests", requests)
+	}
+
+	format := FileFormat("xlsx")
+	if _, err := client.LocaleDownload("p1", "en", &LocaleDownloadParams{FileFormat: &format}); err == nil {
+		t.Errorf("expected an error for a format missing from the formats list")
+	}
+	format = "json"
+	if _, err := client.LocaleDownload("p1", "en", &LocaleDownloadParams{FileFormat: &format}); err != nil {
+		t.Errorf("didn't expect an error, got %q", err)
+	}
+	if requests != 4 {
+		t.Errorf("expected the formats to be fetched once, got %d requests", requests)
+	}
+}
+
+func TestClientValidateParamsFormatsFailing(t *testing.T) {
+	fetches := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/v2/formats" {
+			fetches++
+			w.WriteHeader(http.StatusInternalServerError)
+			io.WriteString(w, `{"message":"down"}`)
+			return
+		}
+		io.WriteString(w, `{}`)
+	}))
+	defer server.Close()
+
+	client := &Client{Credentials: Credentials{Host: server.URL, Token: "secret"}, ValidateParams: true}
+	for i := 0; i < 3; i++ {
+		if _, err := client.LocaleDownload("p1", "en", &LocaleDownloadParams{FileFormat: fileFormat("yml")}); err != nil {
+			t.Fatalf("didn't expect an error, got %q", err)
+		}
+	}
+	if fetches != 1 || client.Formats == nil {
+		t.Errorf("expected a failed fetch not to be retried, got %d fetches", fetches)
+	}
+	if _, err := client.LocaleDownload("p1", "en", &LocaleDownloadParams{FileFormat: fileFormat("not a format")}); err == nil {
+		t.Errorf("expected format names to be checked for their syntax")
+	}
+}
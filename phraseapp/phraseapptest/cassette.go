@@ -0,0 +1,353 @@
+package phraseapptest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// CassetteMode selects whether a Cassette records or replays.
+type CassetteMode int
+
+const (
+	// Replay answers requests from the cassette file and fails on requests
+	// that were not recorded.
+	Replay CassetteMode = iota
+	// Record sends requests and records them for Save.
+	Record
+)
+
+// redactedHeaders are dropped from recorded requests and responses.
+var redactedHeaders = []string{"Authorization", "X-PhraseApp-OTP", "Cookie", "Set-Cookie"}
+
+// Cassette is an http.RoundTripper recording request/response pairs to a
+// file, or replaying them in tests without network access. Set it as the
+// client's Transport:
+//
+//	cassette, err := phraseapptest.NewCassette("testdata/upload.json", phraseapptest.Replay)
+//	client.Transport = cassette
+//
+// Requests are matched by method, path, query and body. JSON and form
+// bodies match regardless of key order, multipart bodies regardless of the
+// boundary and field order. Every recorded interaction is replayed once.
+type Cassette struct {
+	Path string
+	Mode CassetteMode
+
+	// Transport sends the requests in Record mode. It defaults to
+	// http.DefaultTransport.
+	Transport http.RoundTripper
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	played       []bool
+}
+
+// Interaction is a recorded request/response pair.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a request of a cassette, with credentials removed.
+type RecordedRequest struct {
+	Method       string      `json:"method"`
+	URL          string      `json:"url"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body,omitempty"`
+	BodyEncoding string      `json:"body_encoding,omitempty"` // see BodyBase64
+}
+
+// RecordedResponse is a response of a cassette, with tokens redacted.
+type RecordedResponse struct {
+	Status       int         `json:"status"`
+	Header       http.Header `json:"header,omitempty"`
+	Body         string      `json:"body,omitempty"`
+	BodyEncoding string      `json:"body_encoding,omitempty"` // see BodyBase64
+}
+
+// BodyBase64 is the BodyEncoding of recorded bodies that are not valid
+// UTF-8, e.g. xlsx files. Other bodies are stored as is, with tokens
+// redacted.
+const BodyBase64 = "base64"
+
+// UnmatchedRequestError is returned in Replay mode for requests that are
+// not on the cassette.
+type UnmatchedRequestError struct {
+	Cassette string
+	Method   string
+	URL      string
+	Body     string
+}
+
+func (e *UnmatchedRequestError) Error() string {
+	msg := fmt.Sprintf("cassette %s: no recorded interaction matches %s %s", e.Cassette, e.Method, e.URL)
+	if e.Body != "" {
+		msg += " with body " + e.Body
+	}
+	return msg
+}
+
+// NewCassette returns a cassette for the file at path. In Replay mode the
+// file is loaded and must exist.
+func NewCassette(path string, mode CassetteMode) (*Cassette, error) {
+	c := &Cassette{Path: path, Mode: mode}
+	if mode == Record {
+		return c, nil
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(b, &c.interactions); err != nil {
+		return nil, fmt.Errorf("cassette %s: %s", path, err)
+	}
+	c.played = make([]bool, len(c.interactions))
+	return c, nil
+}
+
+// Save writes the recorded interactions to the cassette file.
+func (c *Cassette) Save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, err := json.MarshalIndent(c.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.Path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(c.Path, append(b, '\n'), 0644)
+}
+
+// Unplayed returns the recorded interactions that were not replayed yet.
+func (c *Cassette) Unplayed() []*Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var unplayed []*Interaction
+	for i, played := range c.played {
+		if !played {
+			unplayed = append(unplayed, c.interactions[i])
+		}
+	}
+	return unplayed
+}
+
+func (c *Cassette) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	if c.Mode == Record {
+		return c.record(req, body)
+	}
+	return c.replay(req, body)
+}
+
+func (c *Cassette) record(req *http.Request, body []byte) (*http.Response, error) {
+	transport := c.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	in := &Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			URL:    redactQuery(req.URL),
+			Header: redactHeaders(req.Header),
+		},
+		Response: RecordedResponse{
+			Status: resp.StatusCode,
+			Header: redactHeaders(resp.Header),
+		},
+	}
+	in.Request.Body, in.Request.BodyEncoding = encodeRecordedBody(body)
+	in.Response.Body, in.Response.BodyEncoding = encodeRecordedBody(respBody)
+	c.interactions = append(c.interactions, in)
+	c.played = append(c.played, true)
+	return resp, nil
+}
+
+func (c *Cassette) replay(req *http.Request, body []byte) (*http.Response, error) {
+	key := matchKey(req.Method, redactQuery(req.URL), req.Header.Get("Content-Type"), body)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, in := range c.interactions {
+		if c.played[i] {
+			continue
+		}
+		r := in.Request
+		recorded, err := decodeRecordedBody(r.Body, r.BodyEncoding)
+		if err != nil {
+			return nil, fmt.Errorf("cassette %s: %s", c.Path, err)
+		}
+		if matchKey(r.Method, r.URL, r.Header.Get("Content-Type"), recorded) != key {
+			continue
+		}
+		respBody, err := decodeRecordedBody(in.Response.Body, in.Response.BodyEncoding)
+		if err != nil {
+			return nil, fmt.Errorf("cassette %s: %s", c.Path, err)
+		}
+
+		c.played[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", in.Response.Status, http.StatusText(in.Response.Status)),
+			StatusCode:    in.Response.Status,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        in.Response.Header.Clone(),
+			Body:          ioutil.NopCloser(bytes.NewReader(respBody)),
+			ContentLength: int64(len(respBody)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, &UnmatchedRequestError{Cassette: c.Path, Method: req.Method, URL: redactQuery(req.URL), Body: redactBody(body)}
+}
+
+func redactHeaders(header http.Header) http.Header {
+	h := header.Clone()
+	for _, name := range redactedHeaders {
+		h.Del(name)
+	}
+	return h
+}
+
+// redactQuery returns the URL without user info and with access tokens in
+// the query redacted.
+func redactQuery(u *url.URL) string {
+	c := *u
+	c.User = nil
+	query := c.Query()
+	if query.Get("access_token") != "" {
+		query.Set("access_token", phraseapp.Redacted)
+		c.RawQuery = query.Encode()
+	}
+	return c.String()
+}
+
+func redactBody(body []byte) string {
+	return string(phraseapp.RedactTokens(body))
+}
+
+// encodeRecordedBody returns a body for the cassette file and its encoding.
+func encodeRecordedBody(body []byte) (string, string) {
+	if !utf8.Valid(body) {
+		return base64.StdEncoding.EncodeToString(body), BodyBase64
+	}
+	return redactBody(body), ""
+}
+
+func decodeRecordedBody(body, encoding string) ([]byte, error) {
+	switch encoding {
+	case "":
+		return []byte(body), nil
+	case BodyBase64:
+		return base64.StdEncoding.DecodeString(body)
+	default:
+		return nil, fmt.Errorf("unknown body encoding %q", encoding)
+	}
+}
+
+// matchKey normalizes a request for matching: the query is sorted, JSON and
+// form bodies are re-encoded with sorted keys and multipart bodies are
+// reduced to their sorted fields.
+func matchKey(method, rawURL, contentType string, body []byte) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return method + " " + rawURL + "\n" + string(body)
+	}
+	return method + " " + u.Path + "?" + u.Query().Encode() + "\n" + normalizeBody(contentType, redactBody(body))
+}
+
+func normalizeBody(contentType, body string) string {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return body
+	}
+
+	switch {
+	case mediaType == "application/json":
+		var v interface{}
+		if err := json.Unmarshal([]byte(body), &v); err != nil {
+			return body
+		}
+		b, _ := json.Marshal(v)
+		return string(b)
+	case mediaType == "application/x-www-form-urlencoded":
+		values, err := url.ParseQuery(body)
+		if err != nil {
+			return body
+		}
+		return values.Encode()
+	case strings.HasPrefix(mediaType, "multipart/"):
+		if fields, err := multipartFields(body, params["boundary"]); err == nil {
+			return fields
+		}
+	}
+	return body
+}
+
+func multipartFields(body, boundary string) (string, error) {
+	var fields []string
+	r := multipart.NewReader(strings.NewReader(body), boundary)
+	for {
+		part, err := r.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		value, err := ioutil.ReadAll(part)
+		if err != nil {
+			return "", err
+		}
+		field := part.FormName()
+		if name := part.FileName(); name != "" {
+			field += "@" + name
+		}
+		fields = append(fields, field+"="+string(value))
+	}
+	sort.Strings(fields)
+	return strings.Join(fields, "\n"), nil
+}
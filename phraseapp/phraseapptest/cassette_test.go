@@ -0,0 +1,159 @@
+package phraseapptest
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func TestCassette(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapptest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := writeFixture(t, dir, "en.yml", "en:\n  hello: Hello\n")
+	path := filepath.Join(dir, "cassettes", "upload.json")
+
+	fake := New()
+	fake.seedProject("app")
+	fake.LocaleCreate("app", &phraseapp.LocaleParams{Name: str("en")})
+	server := httptest.NewServer(NewServer(fake, "secret"))
+	host := server.URL
+
+	calls := func(client *phraseapp.Client) error {
+		if _, err := client.KeysList("app", 1, 10, &phraseapp.KeysListParams{Q: str("hello")}); err != nil {
+			return err
+		}
+		_, err := client.UploadCreate("app", &phraseapp.UploadParams{
+			File:          &file,
+			LocaleID:      str("en"),
+			FormatOptions: map[string]string{"a": "1", "b": "2", "c": "3"},
+		})
+		return err
+	}
+
+	recorder, _ := NewCassette(path, Record)
+	client, _ := phraseapp.NewClient(phraseapp.Credentials{Host: host, Token: "secret"}, false)
+	client.Transport = recorder
+	if err := calls(client); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	server.Close()
+
+	b, _ := ioutil.ReadFile(path)
+	if strings.Contains(string(b), "secret") {
+		t.Errorf("expected the token to be redacted from the cassette")
+	}
+
+	player, err := NewCassette(path, Replay)
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	client, _ = phraseapp.NewClient(phraseapp.Credentials{Host: host, Token: "other"}, false)
+	client.Transport = player
+	if err := calls(client); err != nil {
+		t.Fatalf("expected the calls to be replayed, got %q", err)
+	}
+	if unplayed := player.Unplayed(); len(unplayed) != 0 {
+		t.Errorf("expected all interactions to be played, got %d left", len(unplayed))
+	}
+
+	_, err = client.ProjectShow("app")
+	if err == nil || !strings.Contains(err.Error(), "no recorded interaction matches GET "+host+"/v2/projects/app") {
+		t.Errorf("expected an unmatched request error, got %v", err)
+	}
+}
+
+func TestCassetteBinaryBodies(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapptest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	xlsx := "PK\x03\x04\x14\x00\x08\x08\xff\xfe\x00\x80"
+	file := writeFixture(t, dir, "en.xlsx", xlsx)
+	path := filepath.Join(dir, "cassettes", "xlsx.json")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"id":"u1"}`))
+			return
+		}
+		w.Write([]byte(xlsx))
+	}))
+	host := server.URL
+
+	calls := func(client *phraseapp.Client) ([]byte, error) {
+		if _, err := client.UploadCreate("app", &phraseapp.UploadParams{File: &file, FileFormat: fileFormat("xlsx")}); err != nil {
+			return nil, err
+		}
+		return client.LocaleDownload("app", "en", &phraseapp.LocaleDownloadParams{FileFormat: fileFormat("xlsx")})
+	}
+
+	recorder, _ := NewCassette(path, Record)
+	client, _ := phraseapp.NewClient(phraseapp.Credentials{Host: host, Token: "secret"}, false)
+	client.Transport = recorder
+	if _, err := calls(client); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if err := recorder.Save(); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	server.Close()
+
+	player, err := NewCassette(path, Replay)
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if in := player.interactions[1]; in.Response.BodyEncoding != BodyBase64 || in.Request.BodyEncoding != "" {
+		t.Errorf("expected only the binary body to be encoded, got %q and %q", in.Request.BodyEncoding, in.Response.BodyEncoding)
+	}
+	client, _ = phraseapp.NewClient(phraseapp.Credentials{Host: host, Token: "other"}, false)
+	client.Transport = player
+	body, err := calls(client)
+	if err != nil {
+		t.Fatalf("expected the calls to be replayed, got %q", err)
+	}
+	if !bytes.Equal(body, []byte(xlsx)) {
+		t.Errorf("expected the binary body to be replayed, got %q", body)
+	}
+}
+
+func TestNormalizeBody(t *testing.T) {
+	tests := []struct {
+		contentType, a, b string
+	}{
+		{"application/json", `{"a":1,"b":{"c":2,"d":3}}`, `{"b":{"d":3,"c":2},"a":1}`},
+		{"application/x-www-form-urlencoded", "a=1&b=2", "b=2&a=1"},
+		{
+			"multipart/form-data; boundary=x",
+			"--x\r\nContent-Disposition: form-data; name=\"a\"\r\n\r\n1\r\n--x\r\nContent-Disposition: form-data; name=\"b\"\r\n\r\n2\r\n--x--\r\n",
+			"--x\r\nContent-Disposition: form-data; name=\"b\"\r\n\r\n2\r\n--x\r\nContent-Disposition: form-data; name=\"a\"\r\n\r\n1\r\n--x--\r\n",
+		},
+	}
+	for _, tt := range tests {
+		if a, b := normalizeBody(tt.contentType, tt.a), normalizeBody(tt.contentType, tt.b); a != b {
+			t.Errorf("%s: expected %q and %q to match", tt.contentType, a, b)
+		}
+	}
+
+	if got := redactBody([]byte(`{"id":"1","token":"abc"}`)); got != `{"id":"1","token":"[REDACTED]"}` {
+		t.Errorf("expected the token to be redacted, got %s", got)
+	}
+	h := redactHeaders(http.Header{"Authorization": {"token abc"}, "Accept": {"*/*"}})
+	if h.Get("Authorization") != "" || h.Get("Accept") != "*/*" {
+		t.Errorf("unexpected redacted header %v", h)
+	}
+}
@@ -0,0 +1,270 @@
+// Package phraseapptest provides an in-memory fake of the Phrase API for
+// unit tests of code depending on phraseapp.API.
+package phraseapptest
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// Fake is a stateful in-memory implementation of the projects, locales,
+// keys, translations, tags, uploads and download endpoints. Lists are
+// paginated like the API does (page 1 and 25 per page by default, at most
+// 100 per page), unknown resources fail with phraseapp.ErrNotFound, invalid
+// params with a *phraseapp.ValidationErrorResponse and calls exceeding
+// RateLimit with a *phraseapp.RateLimitingError. Branch params are ignored.
+//
+// All other endpoints fail with a "phraseapptest: <Method> not implemented"
+// error. Embed the fake to implement them for a test.
+type Fake struct {
+	// RateLimit is the number of calls allowed per RateLimitWindow. Zero
+	// disables rate limiting.
+	RateLimit       int
+	RateLimitWindow time.Duration // defaults to 5 minutes
+
+	mu          sync.Mutex
+	projects    []*project
+	lastID      int
+	calls       int
+	windowStart time.Time
+}
+
+type project struct {
+	phraseapp.ProjectDetails
+
+	locales      []*phraseapp.LocaleDetails
+	keys         []*phraseapp.TranslationKeyDetails
+	translations []*phraseapp.TranslationDetails
+	tags         []*phraseapp.Tag
+	uploads      []*phraseapp.Upload
+}
+
+// New returns an empty fake.
+func New() *Fake {
+	return &Fake{}
+}
+
+// ResetRateLimit starts a new rate limit window.
+func (f *Fake) ResetRateLimit() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = 0
+	f.windowStart = time.Now()
+}
+
+// call counts a request against the rate limit. It must be called with
+// f.mu held.
+func (f *Fake) call() error {
+	if f.RateLimit <= 0 {
+		return nil
+	}
+
+	reset := f.rateLimitReset()
+	if f.calls >= f.RateLimit {
+		return &phraseapp.RateLimitingError{Limit: f.RateLimit, Remaining: 0, Reset: reset}
+	}
+	f.calls++
+	return nil
+}
+
+// rateLimitReset starts a new rate limit window if the current one is over
+// and returns its end. It must be called with f.mu held.
+func (f *Fake) rateLimitReset() time.Time {
+	window := f.RateLimitWindow
+	if window <= 0 {
+		window = 5 * time.Minute
+	}
+	if now := time.Now(); now.Sub(f.windowStart) >= window {
+		f.calls = 0
+		f.windowStart = now
+	}
+	return f.windowStart.Add(window)
+}
+
+// rateLimitStatus returns the rate limit, the calls remaining and the end
+// of the current window. The limit is zero if rate limiting is disabled.
+func (f *Fake) rateLimitStatus() (int, int, time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.RateLimit <= 0 {
+		return 0, 0, time.Time{}
+	}
+	reset := f.rateLimitReset()
+	return f.RateLimit, f.RateLimit - f.calls, reset
+}
+
+func (f *Fake) newID() string {
+	f.lastID++
+	return fmt.Sprintf("%032x", f.lastID)
+}
+
+func now() *time.Time {
+	t := time.Now().UTC()
+	return &t
+}
+
+func notFound() error {
+	return phraseapp.ErrNotFound{Message: `{"message":"Not Found"}`}
+}
+
+func invalid(resource, field, message string) error {
+	return &phraseapp.ValidationErrorResponse{
+		ErrorResponse: phraseapp.ErrorResponse{Message: "Validation failed"},
+		Errors:        []phraseapp.ValidationErrorMessage{{Resource: resource, Field: field, Message: message}},
+	}
+}
+
+// paginate returns the bounds of the requested page of n items.
+func paginate(n, page, perPage int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+	if perPage < 1 {
+		perPage = 25
+	}
+	if perPage > 100 {
+		perPage = 100
+	}
+
+	start := (page - 1) * perPage
+	if start > n {
+		start = n
+	}
+	end := start + perPage
+	if end > n {
+		end = n
+	}
+	return start, end
+}
+
+func (f *Fake) project(id string) (*project, error) {
+	for _, p := range f.projects {
+		if p.ID == id {
+			return p, nil
+		}
+	}
+	return nil, notFound()
+}
+
+func (p *project) locale(id string) (*phraseapp.LocaleDetails, error) {
+	for _, l := range p.locales {
+		if l.ID == id || l.Name == id || l.Code == id {
+			return l, nil
+		}
+	}
+	return nil, notFound()
+}
+
+func (p *project) key(id string) (*phraseapp.TranslationKeyDetails, error) {
+	for _, k := range p.keys {
+		if k.ID == id {
+			return k, nil
+		}
+	}
+	return nil, notFound()
+}
+
+func (p *project) keyByName(name string) *phraseapp.TranslationKeyDetails {
+	for _, k := range p.keys {
+		if k.Name == name {
+			return k
+		}
+	}
+	return nil
+}
+
+func (p *project) translation(id string) (*phraseapp.TranslationDetails, error) {
+	for _, t := range p.translations {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	return nil, notFound()
+}
+
+func (p *project) translationOf(keyID, localeID string) *phraseapp.TranslationDetails {
+	for _, t := range p.translations {
+		if t.Key.ID == keyID && t.Locale.ID == localeID {
+			return t
+		}
+	}
+	return nil
+}
+
+func (p *project) tag(name string) *phraseapp.Tag {
+	for _, t := range p.tags {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// addTags creates the tags of a comma separated list that don't exist yet
+// and returns the list's names.
+func (p *project) addTags(list string) []string {
+	var names []string
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if p.tag(name) == nil {
+			p.tags = append(p.tags, &phraseapp.Tag{Name: name, CreatedAt: now(), UpdatedAt: now()})
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+func (p *project) keysCount(tag string) int64 {
+	var n int64
+	for _, k := range p.keys {
+		if contains(k.Tags, tag) {
+			n++
+		}
+	}
+	return n
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func mergeTags(tags, add []string) []string {
+	for _, t := range add {
+		if !contains(tags, t) {
+			tags = append(tags, t)
+		}
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+func localePreview(l *phraseapp.LocaleDetails) *phraseapp.LocalePreview {
+	return &phraseapp.LocalePreview{ID: l.ID, Name: l.Name, Code: l.Code}
+}
+
+func translationState(content string, unverified bool) string {
+	switch {
+	case content == "":
+		return "untranslated"
+	case unverified:
+		return "unverified"
+	}
+	return "translated"
+}
+
+func slug(name string) string {
+	return strings.Join(strings.Fields(strings.ToLower(name)), "-")
+}
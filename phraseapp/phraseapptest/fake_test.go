@@ -0,0 +1,176 @@
+package phraseapptest
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/phrase/phraseapp-go/phraseapp"
+)
+
+var _ phraseapp.API = New()
+
+func str(s string) *string { return &s }
+
+func fileFormat(f phraseapp.FileFormat) *phraseapp.FileFormat { return &f }
+
+func TestFakeResources(t *testing.T) {
+	var api phraseapp.API = New()
+
+	if _, err := api.ProjectCreate(&phraseapp.ProjectParams{}); !isValidation(err, "name") {
+		t.Errorf("expected a validation error for name, got %v", err)
+	}
+	project, err := api.ProjectCreate(&phraseapp.ProjectParams{Name: str("App"), MainFormat: str(string(phraseapp.FormatYML))})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if _, err := api.ProjectCreate(&phraseapp.ProjectParams{Name: str("App")}); !isValidation(err, "name") {
+		t.Errorf("expected a validation error for a taken name, got %v", err)
+	}
+	if _, err := api.ProjectShow("missing"); !phraseapp.IsErrNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	de, err := api.LocaleCreate(project.ID, &phraseapp.LocaleParams{Name: str("de"), Code: str("de-DE")})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	key, err := api.KeyCreate(project.ID, &phraseapp.TranslationKeyParams{Name: str("greeting"), Tags: str("web, app")})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	tr, err := api.TranslationCreate(project.ID, &phraseapp.TranslationParams{KeyID: &key.ID, LocaleID: &de.ID, Content: str("Hallo")})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if tr.State != "translated" || tr.Key.Name != "greeting" {
+		t.Errorf("unexpected translation %+v", tr)
+	}
+	if _, err := api.TranslationCreate(project.ID, &phraseapp.TranslationParams{KeyID: &key.ID, LocaleID: &de.ID}); !isValidation(err, "key_id") {
+		t.Errorf("expected a validation error for a duplicate translation, got %v", err)
+	}
+
+	tag, err := api.TagShow(project.ID, "web", &phraseapp.TagShowParams{})
+	if err != nil || tag.KeysCount != 1 {
+		t.Errorf("expected tag web with 1 key, got %+v, %v", tag, err)
+	}
+	if err := api.TagDelete(project.ID, "web", &phraseapp.TagDeleteParams{}); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if k, _ := api.KeyShow(project.ID, key.ID, &phraseapp.KeyShowParams{}); strings.Join(k.Tags, ",") != "app" {
+		t.Errorf("expected the tag to be removed from the key, got %q", k.Tags)
+	}
+
+	if err := api.KeyDelete(project.ID, key.ID, &phraseapp.KeyDeleteParams{}); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if _, err := api.TranslationShow(project.ID, tr.ID, &phraseapp.TranslationShowParams{}); !phraseapp.IsErrNotFound(err) {
+		t.Errorf("expected translations of deleted keys to be gone, got %v", err)
+	}
+}
+
+func TestFakeNotImplemented(t *testing.T) {
+	var api phraseapp.API = New()
+	if _, err := api.BranchCreate("p1", &phraseapp.BranchParams{Name: str("b1")}); err == nil || err.Error() != "phraseapptest: BranchCreate not implemented" {
+		t.Errorf("expected a not implemented error, got %v", err)
+	}
+	if err := api.AuthorizationDelete("a1"); err == nil || err.Error() != "phraseapptest: AuthorizationDelete not implemented" {
+		t.Errorf("expected a not implemented error, got %v", err)
+	}
+}
+
+func TestFakePagination(t *testing.T) {
+	fake := New()
+	project, _ := fake.ProjectCreate(&phraseapp.ProjectParams{Name: str("App")})
+	for i := 0; i < 30; i++ {
+		fake.KeyCreate(project.ID, &phraseapp.TranslationKeyParams{Name: str("key" + string(rune('a'+i)))})
+	}
+
+	tests := []struct {
+		page, perPage, exp int
+	}{
+		{1, 0, 25},
+		{2, 0, 5},
+		{3, 10, 10},
+		{4, 10, 0},
+		{1, 500, 30},
+	}
+	for _, tt := range tests {
+		keys, err := fake.KeysList(project.ID, tt.page, tt.perPage, &phraseapp.KeysListParams{})
+		if err != nil {
+			t.Fatalf("didn't expect an error, got %q", err)
+		}
+		if len(keys) != tt.exp {
+			t.Errorf("page %d with %d per page: expected %d keys, got %d", tt.page, tt.perPage, tt.exp, len(keys))
+		}
+	}
+}
+
+func TestFakeRateLimit(t *testing.T) {
+	fake := New()
+	fake.RateLimit = 2
+
+	fake.ProjectsList(1, 10)
+	fake.ProjectsList(1, 10)
+	_, err := fake.ProjectsList(1, 10)
+	if rle, ok := err.(*phraseapp.RateLimitingError); !ok || rle.Limit != 2 || rle.Remaining != 0 {
+		t.Errorf("expected a rate limiting error, got %v", err)
+	}
+
+	fake.ResetRateLimit()
+	if _, err := fake.ProjectsList(1, 10); err != nil {
+		t.Errorf("expected the rate limit to be reset, got %q", err)
+	}
+}
+
+func TestFakeUploadAndDownload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapptest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "en.yml")
+	if err := ioutil.WriteFile(file, []byte("en:\n  home:\n    title: Welcome\n  bye: Goodbye\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	fake := New()
+	project, _ := fake.ProjectCreate(&phraseapp.ProjectParams{Name: str("App"), MainFormat: str(string(phraseapp.FormatYML))})
+	upload, err := fake.UploadCreate(project.ID, &phraseapp.UploadParams{File: &file, Tags: str("release")})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if upload.State != "success" || upload.Summary.LocalesCreated != 1 || upload.Summary.TranslationKeysCreated != 2 || upload.Summary.TranslationsCreated != 2 {
+		t.Errorf("unexpected upload summary %+v", upload.Summary)
+	}
+
+	b, err := fake.LocaleDownload(project.ID, "en", &phraseapp.LocaleDownloadParams{})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if exp := "en:\n  bye: Goodbye\n  home:\n    title: Welcome\n"; string(b) != exp {
+		t.Errorf("expected download %q, got %q", exp, b)
+	}
+
+	b, err = fake.LocaleDownload(project.ID, "en", &phraseapp.LocaleDownloadParams{FileFormat: fileFormat(phraseapp.FormatSimpleJSON), Tags: str("release")})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if !strings.Contains(string(b), `"home.title": "Welcome"`) {
+		t.Errorf("expected flat json, got %s", b)
+	}
+
+	if _, err := fake.LocaleDownload(project.ID, "en", &phraseapp.LocaleDownloadParams{FileFormat: fileFormat("xlsx")}); !isValidation(err, "file_format") {
+		t.Errorf("expected a validation error for an unsupported format, got %v", err)
+	}
+	if _, err := fake.LocaleDownload(project.ID, "fr", &phraseapp.LocaleDownloadParams{}); !phraseapp.IsErrNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+}
+
+func isValidation(err error, field string) bool {
+	verr, ok := err.(*phraseapp.ValidationErrorResponse)
+	return ok && len(verr.Errors) == 1 && verr.Errors[0].Field == field
+}
@@ -0,0 +1,685 @@
+package phraseapptest
+
+import (
+	"strings"
+
+	"github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func (f *Fake) ProjectCreate(params *phraseapp.ProjectParams) (*phraseapp.ProjectDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	if params.Name == nil || *params.Name == "" {
+		return nil, invalid("Project", "name", "can't be blank")
+	}
+	for _, p := range f.projects {
+		if p.Name == *params.Name {
+			return nil, invalid("Project", "name", "has already been taken")
+		}
+	}
+
+	p := &project{}
+	p.ID = f.newID()
+	p.CreatedAt = now()
+	p.applyParams(params)
+	f.projects = append(f.projects, p)
+
+	details := p.ProjectDetails
+	return &details, nil
+}
+
+func (p *project) applyParams(params *phraseapp.ProjectParams) {
+	if params.Name != nil {
+		p.Name = *params.Name
+		p.Slug = slug(p.Name)
+	}
+	if params.MainFormat != nil {
+		p.MainFormat = *params.MainFormat
+	}
+	if params.SharesTranslationMemory != nil {
+		p.SharesTranslationMemory = *params.SharesTranslationMemory
+	}
+	p.UpdatedAt = now()
+}
+
+func (f *Fake) ProjectDelete(id string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return err
+	}
+
+	for i, p := range f.projects {
+		if p.ID == id {
+			f.projects = append(f.projects[:i], f.projects[i+1:]...)
+			return nil
+		}
+	}
+	return notFound()
+}
+
+func (f *Fake) ProjectShow(id string) (*phraseapp.ProjectDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(id)
+	if err != nil {
+		return nil, err
+	}
+	details := p.ProjectDetails
+	return &details, nil
+}
+
+func (f *Fake) ProjectUpdate(id string, params *phraseapp.ProjectParams) (*phraseapp.ProjectDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(id)
+	if err != nil {
+		return nil, err
+	}
+	if params.Name != nil && *params.Name == "" {
+		return nil, invalid("Project", "name", "can't be blank")
+	}
+	p.applyParams(params)
+
+	details := p.ProjectDetails
+	return &details, nil
+}
+
+func (f *Fake) ProjectsList(page, perPage int) ([]*phraseapp.Project, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	start, end := paginate(len(f.projects), page, perPage)
+	list := []*phraseapp.Project{}
+	for _, p := range f.projects[start:end] {
+		project := p.Project
+		list = append(list, &project)
+	}
+	return list, nil
+}
+
+func (f *Fake) LocaleCreate(project_id string, params *phraseapp.LocaleParams) (*phraseapp.LocaleDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return nil, err
+	}
+	if params.Name == nil || *params.Name == "" {
+		return nil, invalid("Locale", "name", "can't be blank")
+	}
+	if _, err := p.locale(*params.Name); err == nil {
+		return nil, invalid("Locale", "name", "has already been taken")
+	}
+
+	l := &phraseapp.LocaleDetails{}
+	l.ID = f.newID()
+	l.CreatedAt = now()
+	l.Default = len(p.locales) == 0
+	if err := p.applyLocaleParams(l, params); err != nil {
+		return nil, err
+	}
+	p.locales = append(p.locales, l)
+
+	locale := *l
+	return &locale, nil
+}
+
+func (p *project) applyLocaleParams(l *phraseapp.LocaleDetails, params *phraseapp.LocaleParams) error {
+	if params.SourceLocaleID != nil {
+		source, err := p.locale(*params.SourceLocaleID)
+		if err != nil {
+			return invalid("Locale", "source_locale_id", "is invalid")
+		}
+		l.SourceLocale = localePreview(source)
+	}
+	if params.Name != nil {
+		l.Name = *params.Name
+	}
+	if params.Code != nil {
+		l.Code = *params.Code
+	}
+	if params.Main != nil {
+		l.Main = *params.Main
+	}
+	if params.Rtl != nil {
+		l.Rtl = *params.Rtl
+	}
+	if params.Default != nil && *params.Default {
+		for _, other := range p.locales {
+			other.Default = false
+		}
+		l.Default = true
+	}
+	l.UpdatedAt = now()
+	return nil
+}
+
+func (f *Fake) LocaleDelete(project_id, id string, params *phraseapp.LocaleDeleteParams) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return err
+	}
+	l, err := p.locale(id)
+	if err != nil {
+		return err
+	}
+
+	for i, other := range p.locales {
+		if other == l {
+			p.locales = append(p.locales[:i], p.locales[i+1:]...)
+			break
+		}
+	}
+	translations := p.translations[:0]
+	for _, t := range p.translations {
+		if t.Locale.ID != l.ID {
+			translations = append(translations, t)
+		}
+	}
+	p.translations = translations
+	return nil
+}
+
+func (f *Fake) LocaleShow(project_id, id string, params *phraseapp.LocaleShowParams) (*phraseapp.LocaleDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return nil, err
+	}
+	l, err := p.locale(id)
+	if err != nil {
+		return nil, err
+	}
+	locale := *l
+	return &locale, nil
+}
+
+func (f *Fake) LocaleUpdate(project_id, id string, params *phraseapp.LocaleParams) (*phraseapp.LocaleDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return nil, err
+	}
+	l, err := p.locale(id)
+	if err != nil {
+		return nil, err
+	}
+	if params.Name != nil {
+		if *params.Name == "" {
+			return nil, invalid("Locale", "name", "can't be blank")
+		}
+		if other, err := p.locale(*params.Name); err == nil && other != l {
+			return nil, invalid("Locale", "name", "has already been taken")
+		}
+	}
+	if err := p.applyLocaleParams(l, params); err != nil {
+		return nil, err
+	}
+
+	locale := *l
+	return &locale, nil
+}
+
+func (f *Fake) LocalesList(project_id string, page, perPage int, params *phraseapp.LocalesListParams) ([]*phraseapp.Locale, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return nil, err
+	}
+	start, end := paginate(len(p.locales), page, perPage)
+	list := []*phraseapp.Locale{}
+	for _, l := range p.locales[start:end] {
+		locale := l.Locale
+		list = append(list, &locale)
+	}
+	return list, nil
+}
+
+func (f *Fake) KeyCreate(project_id string, params *phraseapp.TranslationKeyParams) (*phraseapp.TranslationKeyDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return nil, err
+	}
+	if params.Name == nil || *params.Name == "" {
+		return nil, invalid("TranslationKey", "name", "can't be blank")
+	}
+	if p.keyByName(*params.Name) != nil {
+		return nil, invalid("TranslationKey", "name", "has already been taken")
+	}
+
+	k := &phraseapp.TranslationKeyDetails{}
+	k.ID = f.newID()
+	k.CreatedAt = now()
+	k.Tags = []string{}
+	p.applyKeyParams(k, params)
+	p.keys = append(p.keys, k)
+
+	key := *k
+	return &key, nil
+}
+
+func (p *project) applyKeyParams(k *phraseapp.TranslationKeyDetails, params *phraseapp.TranslationKeyParams) {
+	if params.Name != nil {
+		k.Name = *params.Name
+	}
+	if params.Description != nil {
+		k.Description = *params.Description
+	}
+	if params.DataType != nil {
+		k.DataType = *params.DataType
+	}
+	if params.Plural != nil {
+		k.Plural = *params.Plural
+	}
+	if params.NamePlural != nil {
+		k.NamePlural = *params.NamePlural
+	}
+	if params.MaxCharactersAllowed != nil {
+		k.MaxCharactersAllowed = *params.MaxCharactersAllowed
+	}
+	if params.Tags != nil {
+		k.Tags = mergeTags([]string{}, p.addTags(*params.Tags))
+	}
+	k.UpdatedAt = now()
+}
+
+func (f *Fake) KeyDelete(project_id, id string, params *phraseapp.KeyDeleteParams) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return err
+	}
+	k, err := p.key(id)
+	if err != nil {
+		return err
+	}
+
+	for i, other := range p.keys {
+		if other == k {
+			p.keys = append(p.keys[:i], p.keys[i+1:]...)
+			break
+		}
+	}
+	translations := p.translations[:0]
+	for _, t := range p.translations {
+		if t.Key.ID != k.ID {
+			translations = append(translations, t)
+		}
+	}
+	p.translations = translations
+	return nil
+}
+
+func (f *Fake) KeyShow(project_id, id string, params *phraseapp.KeyShowParams) (*phraseapp.TranslationKeyDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return nil, err
+	}
+	k, err := p.key(id)
+	if err != nil {
+		return nil, err
+	}
+	key := *k
+	return &key, nil
+}
+
+func (f *Fake) KeyUpdate(project_id, id string, params *phraseapp.TranslationKeyParams) (*phraseapp.TranslationKeyDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return nil, err
+	}
+	k, err := p.key(id)
+	if err != nil {
+		return nil, err
+	}
+	if params.Name != nil {
+		if *params.Name == "" {
+			return nil, invalid("TranslationKey", "name", "can't be blank")
+		}
+		if other := p.keyByName(*params.Name); other != nil && other != k {
+			return nil, invalid("TranslationKey", "name", "has already been taken")
+		}
+	}
+	p.applyKeyParams(k, params)
+
+	key := *k
+	return &key, nil
+}
+
+// KeysList returns the keys of a project. The Q param matches key names by
+// substring.
+func (f *Fake) KeysList(project_id string, page, perPage int, params *phraseapp.KeysListParams) ([]*phraseapp.TranslationKey, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return nil, err
+	}
+
+	var keys []*phraseapp.TranslationKeyDetails
+	for _, k := range p.keys {
+		if params == nil || params.Q == nil || strings.Contains(k.Name, *params.Q) {
+			keys = append(keys, k)
+		}
+	}
+
+	start, end := paginate(len(keys), page, perPage)
+	list := []*phraseapp.TranslationKey{}
+	for _, k := range keys[start:end] {
+		key := k.TranslationKey
+		list = append(list, &key)
+	}
+	return list, nil
+}
+
+func (f *Fake) TranslationCreate(project_id string, params *phraseapp.TranslationParams) (*phraseapp.TranslationDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return nil, err
+	}
+	if params.KeyID == nil {
+		return nil, invalid("Translation", "key_id", "can't be blank")
+	}
+	k, err := p.key(*params.KeyID)
+	if err != nil {
+		return nil, invalid("Translation", "key_id", "is invalid")
+	}
+	if params.LocaleID == nil {
+		return nil, invalid("Translation", "locale_id", "can't be blank")
+	}
+	l, err := p.locale(*params.LocaleID)
+	if err != nil {
+		return nil, invalid("Translation", "locale_id", "is invalid")
+	}
+	if p.translationOf(k.ID, l.ID) != nil {
+		return nil, invalid("Translation", "key_id", "has already been taken")
+	}
+
+	t := p.newTranslation(f.newID(), k, l)
+	p.applyTranslationParams(t, &phraseapp.TranslationUpdateParams{
+		Content:      params.Content,
+		Excluded:     params.Excluded,
+		PluralSuffix: params.PluralSuffix,
+		Unverified:   params.Unverified,
+	})
+
+	translation := *t
+	return &translation, nil
+}
+
+func (p *project) newTranslation(id string, k *phraseapp.TranslationKeyDetails, l *phraseapp.LocaleDetails) *phraseapp.TranslationDetails {
+	t := &phraseapp.TranslationDetails{}
+	t.ID = id
+	t.CreatedAt = now()
+	t.Key = &phraseapp.KeyPreview{ID: k.ID, Name: k.Name, Plural: k.Plural}
+	t.Locale = localePreview(l)
+	t.Placeholders = []string{}
+	p.translations = append(p.translations, t)
+	return t
+}
+
+func (p *project) applyTranslationParams(t *phraseapp.TranslationDetails, params *phraseapp.TranslationUpdateParams) {
+	if params.Content != nil {
+		t.Content = *params.Content
+		t.WordCount = int64(len(strings.Fields(t.Content)))
+	}
+	if params.Excluded != nil {
+		t.Excluded = *params.Excluded
+	}
+	if params.PluralSuffix != nil {
+		t.PluralSuffix = *params.PluralSuffix
+	}
+	if params.Unverified != nil {
+		t.Unverified = *params.Unverified
+	}
+	t.State = translationState(t.Content, t.Unverified)
+	t.UpdatedAt = now()
+}
+
+func (f *Fake) TranslationShow(project_id, id string, params *phraseapp.TranslationShowParams) (*phraseapp.TranslationDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return nil, err
+	}
+	t, err := p.translation(id)
+	if err != nil {
+		return nil, err
+	}
+	translation := *t
+	return &translation, nil
+}
+
+func (f *Fake) TranslationUpdate(project_id, id string, params *phraseapp.TranslationUpdateParams) (*phraseapp.TranslationDetails, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return nil, err
+	}
+	t, err := p.translation(id)
+	if err != nil {
+		return nil, err
+	}
+	p.applyTranslationParams(t, params)
+
+	translation := *t
+	return &translation, nil
+}
+
+func (f *Fake) TranslationsList(project_id string, page, perPage int, params *phraseapp.TranslationsListParams) ([]*phraseapp.Translation, error) {
+	return f.translations(project_id, page, perPage, func(*phraseapp.TranslationDetails) bool { return true })
+}
+
+func (f *Fake) TranslationsByKey(project_id, key_id string, page, perPage int, params *phraseapp.TranslationsByKeyParams) ([]*phraseapp.Translation, error) {
+	return f.translations(project_id, page, perPage, func(t *phraseapp.TranslationDetails) bool { return t.Key.ID == key_id })
+}
+
+func (f *Fake) TranslationsByLocale(project_id, locale_id string, page, perPage int, params *phraseapp.TranslationsByLocaleParams) ([]*phraseapp.Translation, error) {
+	return f.translations(project_id, page, perPage, func(t *phraseapp.TranslationDetails) bool { return t.Locale.ID == locale_id })
+}
+
+func (f *Fake) translations(project_id string, page, perPage int, match func(*phraseapp.TranslationDetails) bool) ([]*phraseapp.Translation, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return nil, err
+	}
+
+	var translations []*phraseapp.TranslationDetails
+	for _, t := range p.translations {
+		if match(t) {
+			translations = append(translations, t)
+		}
+	}
+
+	start, end := paginate(len(translations), page, perPage)
+	list := []*phraseapp.Translation{}
+	for _, t := range translations[start:end] {
+		translation := t.Translation
+		list = append(list, &translation)
+	}
+	return list, nil
+}
+
+func (f *Fake) TagCreate(project_id string, params *phraseapp.TagParams) (*phraseapp.TagWithStats, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return nil, err
+	}
+	if params.Name == nil || strings.TrimSpace(*params.Name) == "" {
+		return nil, invalid("Tag", "name", "can't be blank")
+	}
+	name := strings.TrimSpace(*params.Name)
+	if p.tag(name) != nil {
+		return nil, invalid("Tag", "name", "has already been taken")
+	}
+
+	t := &phraseapp.Tag{Name: name, CreatedAt: now(), UpdatedAt: now()}
+	p.tags = append(p.tags, t)
+	return &phraseapp.TagWithStats{Tag: *t}, nil
+}
+
+func (f *Fake) TagDelete(project_id, name string, params *phraseapp.TagDeleteParams) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return err
+	}
+	for i, t := range p.tags {
+		if t.Name == name {
+			p.tags = append(p.tags[:i], p.tags[i+1:]...)
+			for _, k := range p.keys {
+				tags := []string{}
+				for _, tag := range k.Tags {
+					if tag != name {
+						tags = append(tags, tag)
+					}
+				}
+				k.Tags = tags
+			}
+			return nil
+		}
+	}
+	return notFound()
+}
+
+func (f *Fake) TagShow(project_id, name string, params *phraseapp.TagShowParams) (*phraseapp.TagWithStats, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return nil, err
+	}
+	t := p.tag(name)
+	if t == nil {
+		return nil, notFound()
+	}
+	tag := &phraseapp.TagWithStats{Tag: *t}
+	tag.KeysCount = p.keysCount(t.Name)
+	return tag, nil
+}
+
+func (f *Fake) TagsList(project_id string, page, perPage int, params *phraseapp.TagsListParams) ([]*phraseapp.Tag, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return nil, err
+	}
+	start, end := paginate(len(p.tags), page, perPage)
+	list := []*phraseapp.Tag{}
+	for _, t := range p.tags[start:end] {
+		tag := *t
+		tag.KeysCount = p.keysCount(t.Name)
+		list = append(list, &tag)
+	}
+	return list, nil
+}
@@ -0,0 +1,89 @@
+package phraseapptest
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// fixtureFormats maps the extensions of fixture files to formats.
+var fixtureFormats = map[string]phraseapp.FileFormat{
+	".yml":  phraseapp.FormatYML,
+	".yaml": phraseapp.FormatYML,
+	".json": phraseapp.FormatNestedJSON,
+}
+
+// Seed loads the projects of a fixtures directory. Every subdirectory is a
+// project whose ID and name are the directory's name. Its locale files are
+// named after the locale, e.g. en.yml or de.json, and imported as verified
+// translations. Other files are ignored.
+//
+//	fixtures/
+//	  app/
+//	    en.yml
+//	    de.yml
+func (f *Fake) Seed(dir string) error {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		id := entry.Name()
+		f.seedProject(id)
+
+		files, err := ioutil.ReadDir(filepath.Join(dir, id))
+		if err != nil {
+			return err
+		}
+		for _, file := range files {
+			ext := filepath.Ext(file.Name())
+			format, ok := fixtureFormats[ext]
+			if file.IsDir() || !ok {
+				continue
+			}
+
+			locale := strings.TrimSuffix(file.Name(), ext)
+			if _, err := f.LocaleShow(id, locale, &phraseapp.LocaleShowParams{}); phraseapp.IsErrNotFound(err) {
+				_, err = f.LocaleCreate(id, &phraseapp.LocaleParams{Name: &locale, Code: &locale})
+				if err != nil {
+					return err
+				}
+			}
+
+			path := filepath.Join(dir, id, file.Name())
+			yes := true
+			_, err := f.UploadCreate(id, &phraseapp.UploadParams{
+				File:               &path,
+				FileFormat:         &format,
+				LocaleID:           &locale,
+				SkipUnverification: &yes,
+				SkipUploadTags:     &yes,
+				UpdateTranslations: &yes,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (f *Fake) seedProject(id string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, err := f.project(id); err == nil {
+		return
+	}
+
+	p := &project{}
+	p.ID = id
+	p.CreatedAt = now()
+	p.applyParams(&phraseapp.ProjectParams{Name: &id})
+	f.projects = append(f.projects, p)
+}
@@ -0,0 +1,588 @@
+package phraseapptest
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// Server serves the /v2 routes of the endpoints Fake implements, plus
+// /v2/user, so tools not written in Go can be tested against the fake too.
+// Every request must be authenticated. Responses carry the X-Rate-Limit-*
+// headers if the fake is rate limited, and locale downloads support ETags.
+type Server struct {
+	Fake *Fake
+
+	// Token is the access token requests must send as "token <Token>" or
+	// "Bearer <Token>". If empty, any token or basic auth is accepted.
+	Token string
+
+	routes []route
+}
+
+type route struct {
+	method  string
+	pattern []string
+	handle  func(w http.ResponseWriter, r *http.Request, args []string) error
+}
+
+// NewServer returns a server for the fake. Use it with httptest.NewServer
+// or http.ListenAndServe.
+func NewServer(fake *Fake, token string) *Server {
+	s := &Server{Fake: fake, Token: token}
+	s.routes = []route{
+		{"GET", []string{"user"}, s.showUser},
+		{"GET", []string{"projects"}, s.listProjects},
+		{"POST", []string{"projects"}, s.createProject},
+		{"GET", []string{"projects", "*"}, s.showProject},
+		{"PATCH", []string{"projects", "*"}, s.updateProject},
+		{"DELETE", []string{"projects", "*"}, s.deleteProject},
+		{"GET", []string{"projects", "*", "locales"}, s.listLocales},
+		{"POST", []string{"projects", "*", "locales"}, s.createLocale},
+		{"GET", []string{"projects", "*", "locales", "*"}, s.showLocale},
+		{"PATCH", []string{"projects", "*", "locales", "*"}, s.updateLocale},
+		{"DELETE", []string{"projects", "*", "locales", "*"}, s.deleteLocale},
+		{"GET", []string{"projects", "*", "locales", "*", "download"}, s.downloadLocale},
+		{"GET", []string{"projects", "*", "locales", "*", "translations"}, s.listLocaleTranslations},
+		{"GET", []string{"projects", "*", "keys"}, s.listKeys},
+		{"POST", []string{"projects", "*", "keys"}, s.createKey},
+		{"GET", []string{"projects", "*", "keys", "*"}, s.showKey},
+		{"PATCH", []string{"projects", "*", "keys", "*"}, s.updateKey},
+		{"DELETE", []string{"projects", "*", "keys", "*"}, s.deleteKey},
+		{"GET", []string{"projects", "*", "keys", "*", "translations"}, s.listKeyTranslations},
+		{"GET", []string{"projects", "*", "translations"}, s.listTranslations},
+		{"POST", []string{"projects", "*", "translations"}, s.createTranslation},
+		{"GET", []string{"projects", "*", "translations", "*"}, s.showTranslation},
+		{"PATCH", []string{"projects", "*", "translations", "*"}, s.updateTranslation},
+		{"GET", []string{"projects", "*", "tags"}, s.listTags},
+		{"POST", []string{"projects", "*", "tags"}, s.createTag},
+		{"GET", []string{"projects", "*", "tags", "*"}, s.showTag},
+		{"DELETE", []string{"projects", "*", "tags", "*"}, s.deleteTag},
+		{"GET", []string{"projects", "*", "uploads"}, s.listUploads},
+		{"POST", []string{"projects", "*", "uploads"}, s.createUpload},
+		{"GET", []string{"projects", "*", "uploads", "*"}, s.showUpload},
+	}
+	return s
+}
+
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !s.authenticated(r) {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"message": "Unauthorized"})
+		return
+	}
+
+	w = &rateLimitWriter{ResponseWriter: w, fake: s.Fake}
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.EscapedPath(), "/v2"), "/"), "/")
+	for _, rt := range s.routes {
+		args, ok := rt.match(segments)
+		if !ok || rt.method != r.Method {
+			continue
+		}
+		if err := rt.handle(w, r, args); err != nil {
+			writeError(w, err)
+		}
+		return
+	}
+	writeError(w, notFound())
+}
+
+// rateLimitWriter adds the rate limit headers once the request was counted.
+type rateLimitWriter struct {
+	http.ResponseWriter
+	fake        *Fake
+	wroteHeader bool
+}
+
+func (w *rateLimitWriter) WriteHeader(status int) {
+	if limit, remaining, reset := w.fake.rateLimitStatus(); limit > 0 && !w.wroteHeader {
+		w.Header().Set("X-Rate-Limit-Limit", strconv.Itoa(limit))
+		w.Header().Set("X-Rate-Limit-Remaining", strconv.Itoa(remaining))
+		w.Header().Set("X-Rate-Limit-Reset", strconv.FormatInt(reset.Unix(), 10))
+	}
+	w.wroteHeader = true
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *rateLimitWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (s *Server) authenticated(r *http.Request) bool {
+	auth := r.Header.Get("Authorization")
+	if s.Token == "" {
+		return auth != ""
+	}
+	return auth == "token "+s.Token || auth == "Bearer "+s.Token
+}
+
+func (rt route) match(segments []string) ([]string, bool) {
+	if len(segments) != len(rt.pattern) {
+		return nil, false
+	}
+
+	var args []string
+	for i, p := range rt.pattern {
+		switch p {
+		case "*":
+			arg, err := url.PathUnescape(segments[i])
+			if err != nil {
+				return nil, false
+			}
+			args = append(args, arg)
+		case segments[i]:
+		default:
+			return nil, false
+		}
+	}
+	return args, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	switch err := err.(type) {
+	case phraseapp.ErrNotFound:
+		writeJSON(w, http.StatusNotFound, map[string]string{"message": "Not Found"})
+	case *phraseapp.ValidationErrorResponse:
+		errors := []map[string]string{}
+		for _, e := range err.Errors {
+			errors = append(errors, map[string]string{"resource": e.Resource, "field": e.Field, "message": e.Message})
+		}
+		writeJSON(w, http.StatusUnprocessableEntity, map[string]interface{}{"message": err.Message, "errors": errors})
+	case *phraseapp.RateLimitingError:
+		w.Header().Set("X-Rate-Limit-Limit", strconv.Itoa(err.Limit))
+		w.Header().Set("X-Rate-Limit-Remaining", strconv.Itoa(err.Remaining))
+		w.Header().Set("X-Rate-Limit-Reset", strconv.FormatInt(err.Reset.Unix(), 10))
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprintln(w, "Rate limit exceeded")
+	default:
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": err.Error()})
+	}
+}
+
+// pagination returns the page and per_page query params.
+func pagination(r *http.Request) (int, int) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+	return page, perPage
+}
+
+// decodeBody decodes the JSON or multipart body of a create or update
+// request.
+func decodeBody(r *http.Request, params interface{}) error {
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/") {
+		if err := r.ParseMultipartForm(32 << 20); err != nil {
+			return err
+		}
+		return decodeValues(r.MultipartForm.Value, params)
+	}
+
+	b, err := ioutil.ReadAll(r.Body)
+	if err != nil || len(b) == 0 {
+		return err
+	}
+	return json.Unmarshal(b, params)
+}
+
+// decodeValues sets the fields of a params struct from query or form
+// values named after the fields' json tags. Map fields are sent as
+// name[key]=value.
+func decodeValues(values url.Values, params interface{}) error {
+	v := reflect.ValueOf(params).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		field := v.Field(i)
+
+		if field.Kind() == reflect.Map {
+			m := map[string]string{}
+			for k, vals := range values {
+				if strings.HasPrefix(k, name+"[") && strings.HasSuffix(k, "]") {
+					m[k[len(name)+1:len(k)-1]] = vals[0]
+				}
+			}
+			if len(m) > 0 {
+				field.Set(reflect.ValueOf(m))
+			}
+			continue
+		}
+
+		raw, ok := values[name]
+		if !ok || field.Kind() != reflect.Ptr {
+			continue
+		}
+		val := reflect.New(field.Type().Elem())
+		switch val.Elem().Kind() {
+		case reflect.String:
+			val.Elem().SetString(raw[0])
+		case reflect.Bool:
+			b, err := strconv.ParseBool(raw[0])
+			if err != nil {
+				return fmt.Errorf("invalid value for %s: %q", name, raw[0])
+			}
+			val.Elem().SetBool(b)
+		case reflect.Int64:
+			n, err := strconv.ParseInt(raw[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid value for %s: %q", name, raw[0])
+			}
+			val.Elem().SetInt(n)
+		default:
+			continue
+		}
+		field.Set(val)
+	}
+	return nil
+}
+
+func (s *Server) showUser(w http.ResponseWriter, r *http.Request, args []string) error {
+	writeJSON(w, http.StatusOK, &phraseapp.User{ID: "phraseapptest", Username: "phraseapptest", Name: "Phrase Test"})
+	return nil
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request, args []string) error {
+	page, perPage := pagination(r)
+	projects, err := s.Fake.ProjectsList(page, perPage)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, projects)
+	return nil
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request, args []string) error {
+	params := &phraseapp.ProjectParams{}
+	if err := decodeBody(r, params); err != nil {
+		return err
+	}
+	project, err := s.Fake.ProjectCreate(params)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusCreated, project)
+	return nil
+}
+
+func (s *Server) showProject(w http.ResponseWriter, r *http.Request, args []string) error {
+	project, err := s.Fake.ProjectShow(args[0])
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, project)
+	return nil
+}
+
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request, args []string) error {
+	params := &phraseapp.ProjectParams{}
+	if err := decodeBody(r, params); err != nil {
+		return err
+	}
+	project, err := s.Fake.ProjectUpdate(args[0], params)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, project)
+	return nil
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request, args []string) error {
+	if err := s.Fake.ProjectDelete(args[0]); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) listLocales(w http.ResponseWriter, r *http.Request, args []string) error {
+	page, perPage := pagination(r)
+	locales, err := s.Fake.LocalesList(args[0], page, perPage, &phraseapp.LocalesListParams{})
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, locales)
+	return nil
+}
+
+func (s *Server) createLocale(w http.ResponseWriter, r *http.Request, args []string) error {
+	params := &phraseapp.LocaleParams{}
+	if err := decodeBody(r, params); err != nil {
+		return err
+	}
+	locale, err := s.Fake.LocaleCreate(args[0], params)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusCreated, locale)
+	return nil
+}
+
+func (s *Server) showLocale(w http.ResponseWriter, r *http.Request, args []string) error {
+	locale, err := s.Fake.LocaleShow(args[0], args[1], &phraseapp.LocaleShowParams{})
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, locale)
+	return nil
+}
+
+func (s *Server) updateLocale(w http.ResponseWriter, r *http.Request, args []string) error {
+	params := &phraseapp.LocaleParams{}
+	if err := decodeBody(r, params); err != nil {
+		return err
+	}
+	locale, err := s.Fake.LocaleUpdate(args[0], args[1], params)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, locale)
+	return nil
+}
+
+func (s *Server) deleteLocale(w http.ResponseWriter, r *http.Request, args []string) error {
+	if err := s.Fake.LocaleDelete(args[0], args[1], &phraseapp.LocaleDeleteParams{}); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) downloadLocale(w http.ResponseWriter, r *http.Request, args []string) error {
+	params := &phraseapp.LocaleDownloadParams{}
+	if err := decodeValues(r.URL.Query(), params); err != nil {
+		return err
+	}
+	b, err := s.Fake.LocaleDownload(args[0], args[1], params)
+	if err != nil {
+		return err
+	}
+
+	sum := md5.Sum(b)
+	etag := `"` + hex.EncodeToString(sum[:]) + `"`
+	w.Header().Set("ETag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	}
+	w.Write(b)
+	return nil
+}
+
+func (s *Server) listLocaleTranslations(w http.ResponseWriter, r *http.Request, args []string) error {
+	page, perPage := pagination(r)
+	translations, err := s.Fake.TranslationsByLocale(args[0], args[1], page, perPage, &phraseapp.TranslationsByLocaleParams{})
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, translations)
+	return nil
+}
+
+func (s *Server) listKeys(w http.ResponseWriter, r *http.Request, args []string) error {
+	params := &phraseapp.KeysListParams{}
+	if err := decodeValues(r.URL.Query(), params); err != nil {
+		return err
+	}
+	page, perPage := pagination(r)
+	keys, err := s.Fake.KeysList(args[0], page, perPage, params)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, keys)
+	return nil
+}
+
+func (s *Server) createKey(w http.ResponseWriter, r *http.Request, args []string) error {
+	params := &phraseapp.TranslationKeyParams{}
+	if err := decodeBody(r, params); err != nil {
+		return err
+	}
+	key, err := s.Fake.KeyCreate(args[0], params)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusCreated, key)
+	return nil
+}
+
+func (s *Server) showKey(w http.ResponseWriter, r *http.Request, args []string) error {
+	key, err := s.Fake.KeyShow(args[0], args[1], &phraseapp.KeyShowParams{})
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, key)
+	return nil
+}
+
+func (s *Server) updateKey(w http.ResponseWriter, r *http.Request, args []string) error {
+	params := &phraseapp.TranslationKeyParams{}
+	if err := decodeBody(r, params); err != nil {
+		return err
+	}
+	key, err := s.Fake.KeyUpdate(args[0], args[1], params)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, key)
+	return nil
+}
+
+func (s *Server) deleteKey(w http.ResponseWriter, r *http.Request, args []string) error {
+	if err := s.Fake.KeyDelete(args[0], args[1], &phraseapp.KeyDeleteParams{}); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) listKeyTranslations(w http.ResponseWriter, r *http.Request, args []string) error {
+	page, perPage := pagination(r)
+	translations, err := s.Fake.TranslationsByKey(args[0], args[1], page, perPage, &phraseapp.TranslationsByKeyParams{})
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, translations)
+	return nil
+}
+
+func (s *Server) listTranslations(w http.ResponseWriter, r *http.Request, args []string) error {
+	page, perPage := pagination(r)
+	translations, err := s.Fake.TranslationsList(args[0], page, perPage, &phraseapp.TranslationsListParams{})
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, translations)
+	return nil
+}
+
+func (s *Server) createTranslation(w http.ResponseWriter, r *http.Request, args []string) error {
+	params := &phraseapp.TranslationParams{}
+	if err := decodeBody(r, params); err != nil {
+		return err
+	}
+	translation, err := s.Fake.TranslationCreate(args[0], params)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusCreated, translation)
+	return nil
+}
+
+func (s *Server) showTranslation(w http.ResponseWriter, r *http.Request, args []string) error {
+	translation, err := s.Fake.TranslationShow(args[0], args[1], &phraseapp.TranslationShowParams{})
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, translation)
+	return nil
+}
+
+func (s *Server) updateTranslation(w http.ResponseWriter, r *http.Request, args []string) error {
+	params := &phraseapp.TranslationUpdateParams{}
+	if err := decodeBody(r, params); err != nil {
+		return err
+	}
+	translation, err := s.Fake.TranslationUpdate(args[0], args[1], params)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, translation)
+	return nil
+}
+
+func (s *Server) listTags(w http.ResponseWriter, r *http.Request, args []string) error {
+	page, perPage := pagination(r)
+	tags, err := s.Fake.TagsList(args[0], page, perPage, &phraseapp.TagsListParams{})
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, tags)
+	return nil
+}
+
+func (s *Server) createTag(w http.ResponseWriter, r *http.Request, args []string) error {
+	params := &phraseapp.TagParams{}
+	if err := decodeBody(r, params); err != nil {
+		return err
+	}
+	tag, err := s.Fake.TagCreate(args[0], params)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusCreated, tag)
+	return nil
+}
+
+func (s *Server) showTag(w http.ResponseWriter, r *http.Request, args []string) error {
+	tag, err := s.Fake.TagShow(args[0], args[1], &phraseapp.TagShowParams{})
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, tag)
+	return nil
+}
+
+func (s *Server) deleteTag(w http.ResponseWriter, r *http.Request, args []string) error {
+	if err := s.Fake.TagDelete(args[0], args[1], &phraseapp.TagDeleteParams{}); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (s *Server) listUploads(w http.ResponseWriter, r *http.Request, args []string) error {
+	page, perPage := pagination(r)
+	uploads, err := s.Fake.UploadsList(args[0], page, perPage, &phraseapp.UploadsListParams{})
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, uploads)
+	return nil
+}
+
+func (s *Server) createUpload(w http.ResponseWriter, r *http.Request, args []string) error {
+	params := &phraseapp.UploadParams{}
+	if err := decodeBody(r, params); err != nil {
+		return err
+	}
+
+	var content []byte
+	if file, header, err := r.FormFile("file"); err == nil {
+		defer file.Close()
+		if content, err = ioutil.ReadAll(file); err != nil {
+			return err
+		}
+		params.File = &header.Filename
+	}
+
+	upload, err := s.Fake.upload(args[0], params, content)
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusCreated, upload)
+	return nil
+}
+
+func (s *Server) showUpload(w http.ResponseWriter, r *http.Request, args []string) error {
+	upload, err := s.Fake.UploadShow(args[0], args[1], &phraseapp.UploadShowParams{})
+	if err != nil {
+		return err
+	}
+	writeJSON(w, http.StatusOK, upload)
+	return nil
+}
@@ -0,0 +1,131 @@
+package phraseapptest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/phrase/phraseapp-go/phraseapp"
+)
+
+func writeFixture(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestServer(t *testing.T) {
+	dir, err := ioutil.TempDir("", "phraseapptest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeFixture(t, dir, "app/en.yml", "en:\n  hello: Hello\n")
+	writeFixture(t, dir, "app/de.json", `{"hello": "Hallo"}`)
+
+	fake := New()
+	if err := fake.Seed(dir); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	server := httptest.NewServer(NewServer(fake, "secret"))
+	defer server.Close()
+
+	client, _ := phraseapp.NewClient(phraseapp.Credentials{Host: server.URL, Token: "secret"}, false)
+
+	locales, err := client.LocalesList("app", 1, 10, &phraseapp.LocalesListParams{})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if len(locales) != 2 || locales[0].Name != "de" || locales[1].Name != "en" {
+		t.Errorf("expected seeded locales de and en, got %d", len(locales))
+	}
+
+	format := phraseapp.FormatSimpleJSON
+	b, err := client.LocaleDownload("app", "de", &phraseapp.LocaleDownloadParams{FileFormat: &format})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if exp := "{\n  \"hello\": \"Hallo\"\n}"; string(b) != exp {
+		t.Errorf("expected download %q, got %q", exp, b)
+	}
+
+	name := "hello"
+	if _, err := client.KeyCreate("app", &phraseapp.TranslationKeyParams{Name: &name}); err == nil {
+		t.Errorf("expected a validation error for a taken key name")
+	} else if verr, ok := err.(*phraseapp.ValidationErrorResponse); !ok || verr.Errors[0].Field != "name" {
+		t.Errorf("expected a validation error for name, got %v", err)
+	}
+
+	if _, err := client.ProjectShow("missing"); !phraseapp.IsErrNotFound(err) {
+		t.Errorf("expected not found, got %v", err)
+	}
+
+	file := writeFixture(t, dir, "upload/fr.yml", "fr:\n  hello: Bonjour\n")
+	upload, err := client.UploadCreate("app", &phraseapp.UploadParams{File: &file})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if upload.Filename != "fr.yml" || upload.Summary.LocalesCreated != 1 || upload.Summary.TranslationsCreated != 1 {
+		t.Errorf("unexpected upload %+v", upload)
+	}
+
+	unauthorized, _ := phraseapp.NewClient(phraseapp.Credentials{Host: server.URL, Token: "wrong"}, false)
+	if _, err := unauthorized.ProjectsList(1, 10); err == nil {
+		t.Errorf("expected an error for a wrong token")
+	}
+}
+
+func TestServerETag(t *testing.T) {
+	fake := New()
+	project, _ := fake.ProjectCreate(&phraseapp.ProjectParams{Name: str("App"), MainFormat: str(string(phraseapp.FormatYML))})
+	fake.LocaleCreate(project.ID, &phraseapp.LocaleParams{Name: str("en")})
+	server := httptest.NewServer(NewServer(fake, ""))
+	defer server.Close()
+
+	download := func(etag string) *http.Response {
+		req, _ := http.NewRequest("GET", server.URL+"/v2/projects/"+project.ID+"/locales/en/download", nil)
+		req.Header.Set("Authorization", "token any")
+		if etag != "" {
+			req.Header.Set("If-None-Match", etag)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+
+	resp := download("")
+	etag := resp.Header.Get("ETag")
+	if resp.StatusCode != http.StatusOK || etag == "" {
+		t.Fatalf("expected 200 with an ETag, got %d %q", resp.StatusCode, etag)
+	}
+	if resp := download(etag); resp.StatusCode != http.StatusNotModified {
+		t.Errorf("expected 304 for a matching ETag, got %d", resp.StatusCode)
+	}
+}
+
+func TestServerRateLimit(t *testing.T) {
+	fake := New()
+	fake.RateLimit = 1
+	server := httptest.NewServer(NewServer(fake, ""))
+	defer server.Close()
+
+	client, _ := phraseapp.NewClient(phraseapp.Credentials{Host: server.URL, Token: "any"}, false)
+	if _, err := client.ProjectsList(1, 10); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	_, err := client.ProjectsList(1, 10)
+	if rle, ok := err.(*phraseapp.RateLimitingError); !ok || rle.Limit != 1 || rle.Remaining != 0 {
+		t.Errorf("expected a rate limiting error, got %v", err)
+	}
+}
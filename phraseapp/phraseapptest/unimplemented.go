@@ -0,0 +1,548 @@
+package phraseapptest
+
+import (
+	"fmt"
+
+	"github.com/phrase/phraseapp-go/phraseapp"
+)
+
+// notImplemented is the error of the endpoints the fake doesn't implement.
+func notImplemented(method string) error {
+	return fmt.Errorf("phraseapptest: %s not implemented", method)
+}
+
+func (f *Fake) AccountShow(id string) (*phraseapp.AccountDetails, error) {
+	return nil, notImplemented("AccountShow")
+}
+
+func (f *Fake) AccountsList(page, perPage int) ([]*phraseapp.Account, error) {
+	return nil, notImplemented("AccountsList")
+}
+
+func (f *Fake) AuthorizationCreate(params *phraseapp.AuthorizationParams) (*phraseapp.AuthorizationWithToken, error) {
+	return nil, notImplemented("AuthorizationCreate")
+}
+
+func (f *Fake) AuthorizationDelete(id string) error {
+	return notImplemented("AuthorizationDelete")
+}
+
+func (f *Fake) AuthorizationShow(id string) (*phraseapp.Authorization, error) {
+	return nil, notImplemented("AuthorizationShow")
+}
+
+func (f *Fake) AuthorizationUpdate(id string, params *phraseapp.AuthorizationParams) (*phraseapp.Authorization, error) {
+	return nil, notImplemented("AuthorizationUpdate")
+}
+
+func (f *Fake) AuthorizationsList(page, perPage int) ([]*phraseapp.Authorization, error) {
+	return nil, notImplemented("AuthorizationsList")
+}
+
+func (f *Fake) BitbucketSyncExport(id string, params *phraseapp.BitbucketSyncParams) (*phraseapp.BitbucketSyncExportResponse, error) {
+	return nil, notImplemented("BitbucketSyncExport")
+}
+
+func (f *Fake) BitbucketSyncImport(id string, params *phraseapp.BitbucketSyncParams) error {
+	return notImplemented("BitbucketSyncImport")
+}
+
+func (f *Fake) BitbucketSyncsList(page, perPage int, params *phraseapp.BitbucketSyncParams) ([]*phraseapp.BitbucketSync, error) {
+	return nil, notImplemented("BitbucketSyncsList")
+}
+
+func (f *Fake) BlacklistedKeyCreate(project_id string, params *phraseapp.BlacklistedKeyParams) (*phraseapp.BlacklistedKey, error) {
+	return nil, notImplemented("BlacklistedKeyCreate")
+}
+
+func (f *Fake) BlacklistedKeyDelete(project_id, id string) error {
+	return notImplemented("BlacklistedKeyDelete")
+}
+
+func (f *Fake) BlacklistedKeyShow(project_id, id string) (*phraseapp.BlacklistedKey, error) {
+	return nil, notImplemented("BlacklistedKeyShow")
+}
+
+func (f *Fake) BlacklistedKeyUpdate(project_id, id string, params *phraseapp.BlacklistedKeyParams) (*phraseapp.BlacklistedKey, error) {
+	return nil, notImplemented("BlacklistedKeyUpdate")
+}
+
+func (f *Fake) BlacklistedKeysList(project_id string, page, perPage int) ([]*phraseapp.BlacklistedKey, error) {
+	return nil, notImplemented("BlacklistedKeysList")
+}
+
+func (f *Fake) BranchCompare(project_id, name string, params *phraseapp.BranchParams) error {
+	return notImplemented("BranchCompare")
+}
+
+func (f *Fake) BranchCreate(project_id string, params *phraseapp.BranchParams) (*phraseapp.Branch, error) {
+	return nil, notImplemented("BranchCreate")
+}
+
+func (f *Fake) BranchDelete(project_id, name string) error {
+	return notImplemented("BranchDelete")
+}
+
+func (f *Fake) BranchMerge(project_id, name string, params *phraseapp.BranchMergeParams) error {
+	return notImplemented("BranchMerge")
+}
+
+func (f *Fake) BranchShow(project_id, name string) (*phraseapp.Branch, error) {
+	return nil, notImplemented("BranchShow")
+}
+
+func (f *Fake) BranchUpdate(project_id, name string, params *phraseapp.BranchParams) (*phraseapp.Branch, error) {
+	return nil, notImplemented("BranchUpdate")
+}
+
+func (f *Fake) BranchesList(project_id string, page, perPage int) ([]*phraseapp.Branch, error) {
+	return nil, notImplemented("BranchesList")
+}
+
+func (f *Fake) CommentCreate(project_id, key_id string, params *phraseapp.CommentParams) (*phraseapp.Comment, error) {
+	return nil, notImplemented("CommentCreate")
+}
+
+func (f *Fake) CommentDelete(project_id, key_id, id string, params *phraseapp.CommentDeleteParams) error {
+	return notImplemented("CommentDelete")
+}
+
+func (f *Fake) CommentMarkCheck(project_id, key_id, id string, params *phraseapp.CommentMarkCheckParams) error {
+	return notImplemented("CommentMarkCheck")
+}
+
+func (f *Fake) CommentMarkRead(project_id, key_id, id string, params *phraseapp.CommentMarkReadParams) error {
+	return notImplemented("CommentMarkRead")
+}
+
+func (f *Fake) CommentMarkUnread(project_id, key_id, id string, params *phraseapp.CommentMarkUnreadParams) error {
+	return notImplemented("CommentMarkUnread")
+}
+
+func (f *Fake) CommentShow(project_id, key_id, id string, params *phraseapp.CommentShowParams) (*phraseapp.Comment, error) {
+	return nil, notImplemented("CommentShow")
+}
+
+func (f *Fake) CommentUpdate(project_id, key_id, id string, params *phraseapp.CommentParams) (*phraseapp.Comment, error) {
+	return nil, notImplemented("CommentUpdate")
+}
+
+func (f *Fake) CommentsList(project_id, key_id string, page, perPage int, params *phraseapp.CommentsListParams) ([]*phraseapp.Comment, error) {
+	return nil, notImplemented("CommentsList")
+}
+
+func (f *Fake) DistributionCreate(account_id string, params *phraseapp.DistributionsParams) (*phraseapp.Distribution, error) {
+	return nil, notImplemented("DistributionCreate")
+}
+
+func (f *Fake) DistributionDelete(account_id, id string) error {
+	return notImplemented("DistributionDelete")
+}
+
+func (f *Fake) DistributionShow(account_id, id string) (*phraseapp.Distribution, error) {
+	return nil, notImplemented("DistributionShow")
+}
+
+func (f *Fake) DistributionUpdate(account_id, id string, params *phraseapp.DistributionsParams) (*phraseapp.Distribution, error) {
+	return nil, notImplemented("DistributionUpdate")
+}
+
+func (f *Fake) DistributionsList(account_id string, page, perPage int) ([]*phraseapp.DistributionPreview, error) {
+	return nil, notImplemented("DistributionsList")
+}
+
+func (f *Fake) FormatsList(page, perPage int) ([]*phraseapp.Format, error) {
+	return nil, notImplemented("FormatsList")
+}
+
+func (f *Fake) GlossaryTermTranslationCreate(account_id, glossary_id, term_id string, params *phraseapp.GlossaryTermTranslationParams) (*phraseapp.GlossaryTermTranslation, error) {
+	return nil, notImplemented("GlossaryTermTranslationCreate")
+}
+
+func (f *Fake) GlossaryTermTranslationDelete(account_id, glossary_id, term_id, id string) error {
+	return notImplemented("GlossaryTermTranslationDelete")
+}
+
+func (f *Fake) GlossaryTermTranslationUpdate(account_id, glossary_id, term_id, id string, params *phraseapp.GlossaryTermTranslationParams) (*phraseapp.GlossaryTermTranslation, error) {
+	return nil, notImplemented("GlossaryTermTranslationUpdate")
+}
+
+func (f *Fake) GlossaryTermCreate(account_id, glossary_id string, params *phraseapp.GlossaryTermParams) (*phraseapp.GlossaryTerm, error) {
+	return nil, notImplemented("GlossaryTermCreate")
+}
+
+func (f *Fake) GlossaryTermDelete(account_id, glossary_id, id string) error {
+	return notImplemented("GlossaryTermDelete")
+}
+
+func (f *Fake) GlossaryTermShow(account_id, glossary_id, id string) (*phraseapp.GlossaryTerm, error) {
+	return nil, notImplemented("GlossaryTermShow")
+}
+
+func (f *Fake) GlossaryTermUpdate(account_id, glossary_id, id string, params *phraseapp.GlossaryTermParams) (*phraseapp.GlossaryTerm, error) {
+	return nil, notImplemented("GlossaryTermUpdate")
+}
+
+func (f *Fake) GlossaryTermsList(account_id, glossary_id string, page, perPage int) ([]*phraseapp.GlossaryTerm, error) {
+	return nil, notImplemented("GlossaryTermsList")
+}
+
+func (f *Fake) GlossariesList(account_id string, page, perPage int) ([]*phraseapp.Glossary, error) {
+	return nil, notImplemented("GlossariesList")
+}
+
+func (f *Fake) GlossaryCreate(account_id string, params *phraseapp.GlossaryParams) (*phraseapp.Glossary, error) {
+	return nil, notImplemented("GlossaryCreate")
+}
+
+func (f *Fake) GlossaryDelete(account_id, id string) error {
+	return notImplemented("GlossaryDelete")
+}
+
+func (f *Fake) GlossaryShow(account_id, id string) (*phraseapp.Glossary, error) {
+	return nil, notImplemented("GlossaryShow")
+}
+
+func (f *Fake) GlossaryUpdate(account_id, id string, params *phraseapp.GlossaryParams) (*phraseapp.Glossary, error) {
+	return nil, notImplemented("GlossaryUpdate")
+}
+
+func (f *Fake) InvitationCreate(account_id string, params *phraseapp.InvitationCreateParams) (*phraseapp.Invitation, error) {
+	return nil, notImplemented("InvitationCreate")
+}
+
+func (f *Fake) InvitationDelete(account_id, id string) error {
+	return notImplemented("InvitationDelete")
+}
+
+func (f *Fake) InvitationResend(account_id, id string) (*phraseapp.Invitation, error) {
+	return nil, notImplemented("InvitationResend")
+}
+
+func (f *Fake) InvitationShow(account_id, id string) (*phraseapp.Invitation, error) {
+	return nil, notImplemented("InvitationShow")
+}
+
+func (f *Fake) InvitationUpdate(account_id, id string, params *phraseapp.InvitationUpdateParams) (*phraseapp.Invitation, error) {
+	return nil, notImplemented("InvitationUpdate")
+}
+
+func (f *Fake) InvitationsList(account_id string, page, perPage int) ([]*phraseapp.Invitation, error) {
+	return nil, notImplemented("InvitationsList")
+}
+
+func (f *Fake) JobLocaleComplete(project_id, job_id, id string, params *phraseapp.JobLocaleCompleteParams) (*phraseapp.JobLocale, error) {
+	return nil, notImplemented("JobLocaleComplete")
+}
+
+func (f *Fake) JobLocaleDelete(project_id, job_id, id string, params *phraseapp.JobLocaleDeleteParams) error {
+	return notImplemented("JobLocaleDelete")
+}
+
+func (f *Fake) JobLocaleReopen(project_id, job_id, id string, params *phraseapp.JobLocaleReopenParams) (*phraseapp.JobLocale, error) {
+	return nil, notImplemented("JobLocaleReopen")
+}
+
+func (f *Fake) JobLocaleShow(project_id, job_id, id string, params *phraseapp.JobLocaleShowParams) (*phraseapp.JobLocale, error) {
+	return nil, notImplemented("JobLocaleShow")
+}
+
+func (f *Fake) JobLocaleUpdate(project_id, job_id, id string, params *phraseapp.JobLocaleParams) (*phraseapp.JobLocale, error) {
+	return nil, notImplemented("JobLocaleUpdate")
+}
+
+func (f *Fake) JobLocalesCreate(project_id, job_id string, params *phraseapp.JobLocaleParams) (*phraseapp.JobLocale, error) {
+	return nil, notImplemented("JobLocalesCreate")
+}
+
+func (f *Fake) JobLocalesList(project_id, job_id string, page, perPage int, params *phraseapp.JobLocalesListParams) ([]*phraseapp.JobLocale, error) {
+	return nil, notImplemented("JobLocalesList")
+}
+
+func (f *Fake) JobComplete(project_id, id string, params *phraseapp.JobCompleteParams) (*phraseapp.JobDetails, error) {
+	return nil, notImplemented("JobComplete")
+}
+
+func (f *Fake) JobCreate(project_id string, params *phraseapp.JobParams) (*phraseapp.JobDetails, error) {
+	return nil, notImplemented("JobCreate")
+}
+
+func (f *Fake) JobDelete(project_id, id string, params *phraseapp.JobDeleteParams) error {
+	return notImplemented("JobDelete")
+}
+
+func (f *Fake) JobKeysCreate(project_id, id string, params *phraseapp.JobKeysCreateParams) (*phraseapp.JobDetails, error) {
+	return nil, notImplemented("JobKeysCreate")
+}
+
+func (f *Fake) JobKeysDelete(project_id, id string, params *phraseapp.JobKeysDeleteParams) error {
+	return notImplemented("JobKeysDelete")
+}
+
+func (f *Fake) JobReopen(project_id, id string, params *phraseapp.JobReopenParams) (*phraseapp.JobDetails, error) {
+	return nil, notImplemented("JobReopen")
+}
+
+func (f *Fake) JobShow(project_id, id string, params *phraseapp.JobShowParams) (*phraseapp.JobDetails, error) {
+	return nil, notImplemented("JobShow")
+}
+
+func (f *Fake) JobStart(project_id, id string, params *phraseapp.JobStartParams) (*phraseapp.JobDetails, error) {
+	return nil, notImplemented("JobStart")
+}
+
+func (f *Fake) JobUpdate(project_id, id string, params *phraseapp.JobUpdateParams) (*phraseapp.JobDetails, error) {
+	return nil, notImplemented("JobUpdate")
+}
+
+func (f *Fake) JobsList(project_id string, page, perPage int, params *phraseapp.JobsListParams) ([]*phraseapp.Job, error) {
+	return nil, notImplemented("JobsList")
+}
+
+func (f *Fake) KeysDelete(project_id string, params *phraseapp.KeysDeleteParams) (*phraseapp.AffectedResources, error) {
+	return nil, notImplemented("KeysDelete")
+}
+
+func (f *Fake) KeysSearch(project_id string, page, perPage int, params *phraseapp.KeysSearchParams) ([]*phraseapp.TranslationKey, error) {
+	return nil, notImplemented("KeysSearch")
+}
+
+func (f *Fake) KeysTag(project_id string, params *phraseapp.KeysTagParams) (*phraseapp.AffectedResources, error) {
+	return nil, notImplemented("KeysTag")
+}
+
+func (f *Fake) KeysUntag(project_id string, params *phraseapp.KeysUntagParams) (*phraseapp.AffectedResources, error) {
+	return nil, notImplemented("KeysUntag")
+}
+
+func (f *Fake) MemberDelete(account_id, id string) error {
+	return notImplemented("MemberDelete")
+}
+
+func (f *Fake) MemberShow(account_id, id string) (*phraseapp.Member, error) {
+	return nil, notImplemented("MemberShow")
+}
+
+func (f *Fake) MemberUpdate(account_id, id string, params *phraseapp.MemberUpdateParams) (*phraseapp.Member, error) {
+	return nil, notImplemented("MemberUpdate")
+}
+
+func (f *Fake) MembersList(account_id string, page, perPage int) ([]*phraseapp.Member, error) {
+	return nil, notImplemented("MembersList")
+}
+
+func (f *Fake) OrderConfirm(project_id, id string, params *phraseapp.OrderConfirmParams) (*phraseapp.TranslationOrder, error) {
+	return nil, notImplemented("OrderConfirm")
+}
+
+func (f *Fake) OrderCreate(project_id string, params *phraseapp.TranslationOrderParams) (*phraseapp.TranslationOrder, error) {
+	return nil, notImplemented("OrderCreate")
+}
+
+func (f *Fake) OrderDelete(project_id, id string, params *phraseapp.OrderDeleteParams) error {
+	return notImplemented("OrderDelete")
+}
+
+func (f *Fake) OrderShow(project_id, id string, params *phraseapp.OrderShowParams) (*phraseapp.TranslationOrder, error) {
+	return nil, notImplemented("OrderShow")
+}
+
+func (f *Fake) OrdersList(project_id string, page, perPage int, params *phraseapp.OrdersListParams) ([]*phraseapp.TranslationOrder, error) {
+	return nil, notImplemented("OrdersList")
+}
+
+func (f *Fake) ReleaseCreate(account_id, distribution_id string, params *phraseapp.ReleasesParams) (*phraseapp.Release, error) {
+	return nil, notImplemented("ReleaseCreate")
+}
+
+func (f *Fake) ReleaseDelete(account_id, distribution_id, id string) error {
+	return notImplemented("ReleaseDelete")
+}
+
+func (f *Fake) ReleasePublish(account_id, distribution_id, id string) (*phraseapp.Release, error) {
+	return nil, notImplemented("ReleasePublish")
+}
+
+func (f *Fake) ReleaseShow(account_id, distribution_id, id string) (*phraseapp.Release, error) {
+	return nil, notImplemented("ReleaseShow")
+}
+
+func (f *Fake) ReleaseUpdate(account_id, distribution_id, id string, params *phraseapp.ReleasesParams) (*phraseapp.Release, error) {
+	return nil, notImplemented("ReleaseUpdate")
+}
+
+func (f *Fake) ReleasesList(account_id, distribution_id string, page, perPage int) ([]*phraseapp.ReleasePreview, error) {
+	return nil, notImplemented("ReleasesList")
+}
+
+func (f *Fake) ScreenshotMarkerCreate(project_id, screenshot_id string, params *phraseapp.ScreenshotMarkerParams) (*phraseapp.ScreenshotMarker, error) {
+	return nil, notImplemented("ScreenshotMarkerCreate")
+}
+
+func (f *Fake) ScreenshotMarkerDelete(project_id, screenshot_id string) error {
+	return notImplemented("ScreenshotMarkerDelete")
+}
+
+func (f *Fake) ScreenshotMarkerShow(project_id, screenshot_id, id string) (*phraseapp.ScreenshotMarker, error) {
+	return nil, notImplemented("ScreenshotMarkerShow")
+}
+
+func (f *Fake) ScreenshotMarkerUpdate(project_id, screenshot_id string, params *phraseapp.ScreenshotMarkerParams) (*phraseapp.ScreenshotMarker, error) {
+	return nil, notImplemented("ScreenshotMarkerUpdate")
+}
+
+func (f *Fake) ScreenshotMarkersList(project_id, id string, page, perPage int) ([]*phraseapp.ScreenshotMarker, error) {
+	return nil, notImplemented("ScreenshotMarkersList")
+}
+
+func (f *Fake) ScreenshotCreate(project_id string, params *phraseapp.ScreenshotParams) (*phraseapp.Screenshot, error) {
+	return nil, notImplemented("ScreenshotCreate")
+}
+
+func (f *Fake) ScreenshotDelete(project_id, id string) error {
+	return notImplemented("ScreenshotDelete")
+}
+
+func (f *Fake) ScreenshotShow(project_id, id string) (*phraseapp.Screenshot, error) {
+	return nil, notImplemented("ScreenshotShow")
+}
+
+func (f *Fake) ScreenshotUpdate(project_id, id string, params *phraseapp.ScreenshotParams) (*phraseapp.Screenshot, error) {
+	return nil, notImplemented("ScreenshotUpdate")
+}
+
+func (f *Fake) ScreenshotsList(project_id string, page, perPage int) ([]*phraseapp.Screenshot, error) {
+	return nil, notImplemented("ScreenshotsList")
+}
+
+func (f *Fake) ShowUser() (*phraseapp.User, error) {
+	return nil, notImplemented("ShowUser")
+}
+
+func (f *Fake) SpacesProjectsCreate(account_id, space_id string, params *phraseapp.SpacesProjectsCreateParams) error {
+	return notImplemented("SpacesProjectsCreate")
+}
+
+func (f *Fake) SpacesProjectsDelete(account_id, space_id, id string) error {
+	return notImplemented("SpacesProjectsDelete")
+}
+
+func (f *Fake) SpacesProjectsList(account_id, space_id string, page, perPage int) ([]*phraseapp.Project, error) {
+	return nil, notImplemented("SpacesProjectsList")
+}
+
+func (f *Fake) SpaceCreate(account_id string, params *phraseapp.SpaceCreateParams) (*phraseapp.Space, error) {
+	return nil, notImplemented("SpaceCreate")
+}
+
+func (f *Fake) SpaceDelete(account_id, id string) error {
+	return notImplemented("SpaceDelete")
+}
+
+func (f *Fake) SpaceShow(account_id, id string) (*phraseapp.Space, error) {
+	return nil, notImplemented("SpaceShow")
+}
+
+func (f *Fake) SpaceUpdate(account_id, id string, params *phraseapp.SpaceUpdateParams) (*phraseapp.Space, error) {
+	return nil, notImplemented("SpaceUpdate")
+}
+
+func (f *Fake) SpacesList(account_id string, page, perPage int) ([]*phraseapp.Space, error) {
+	return nil, notImplemented("SpacesList")
+}
+
+func (f *Fake) StyleguideCreate(project_id string, params *phraseapp.StyleguideParams) (*phraseapp.StyleguideDetails, error) {
+	return nil, notImplemented("StyleguideCreate")
+}
+
+func (f *Fake) StyleguideDelete(project_id, id string) error {
+	return notImplemented("StyleguideDelete")
+}
+
+func (f *Fake) StyleguideShow(project_id, id string) (*phraseapp.StyleguideDetails, error) {
+	return nil, notImplemented("StyleguideShow")
+}
+
+func (f *Fake) StyleguideUpdate(project_id, id string, params *phraseapp.StyleguideParams) (*phraseapp.StyleguideDetails, error) {
+	return nil, notImplemented("StyleguideUpdate")
+}
+
+func (f *Fake) StyleguidesList(project_id string, page, perPage int) ([]*phraseapp.Styleguide, error) {
+	return nil, notImplemented("StyleguidesList")
+}
+
+func (f *Fake) TranslationExclude(project_id, id string, params *phraseapp.TranslationExcludeParams) (*phraseapp.TranslationDetails, error) {
+	return nil, notImplemented("TranslationExclude")
+}
+
+func (f *Fake) TranslationInclude(project_id, id string, params *phraseapp.TranslationIncludeParams) (*phraseapp.TranslationDetails, error) {
+	return nil, notImplemented("TranslationInclude")
+}
+
+func (f *Fake) TranslationReview(project_id, id string, params *phraseapp.TranslationReviewParams) (*phraseapp.TranslationDetails, error) {
+	return nil, notImplemented("TranslationReview")
+}
+
+func (f *Fake) TranslationUnverify(project_id, id string, params *phraseapp.TranslationUnverifyParams) (*phraseapp.TranslationDetails, error) {
+	return nil, notImplemented("TranslationUnverify")
+}
+
+func (f *Fake) TranslationVerify(project_id, id string, params *phraseapp.TranslationVerifyParams) (*phraseapp.TranslationDetails, error) {
+	return nil, notImplemented("TranslationVerify")
+}
+
+func (f *Fake) TranslationsExclude(project_id string, params *phraseapp.TranslationsExcludeParams) (*phraseapp.AffectedCount, error) {
+	return nil, notImplemented("TranslationsExclude")
+}
+
+func (f *Fake) TranslationsInclude(project_id string, params *phraseapp.TranslationsIncludeParams) (*phraseapp.AffectedCount, error) {
+	return nil, notImplemented("TranslationsInclude")
+}
+
+func (f *Fake) TranslationsReview(project_id string, params *phraseapp.TranslationsReviewParams) (*phraseapp.AffectedCount, error) {
+	return nil, notImplemented("TranslationsReview")
+}
+
+func (f *Fake) TranslationsSearch(project_id string, page, perPage int, params *phraseapp.TranslationsSearchParams) ([]*phraseapp.Translation, error) {
+	return nil, notImplemented("TranslationsSearch")
+}
+
+func (f *Fake) TranslationsUnverify(project_id string, params *phraseapp.TranslationsUnverifyParams) (*phraseapp.AffectedCount, error) {
+	return nil, notImplemented("TranslationsUnverify")
+}
+
+func (f *Fake) TranslationsVerify(project_id string, params *phraseapp.TranslationsVerifyParams) (*phraseapp.AffectedCount, error) {
+	return nil, notImplemented("TranslationsVerify")
+}
+
+func (f *Fake) VersionShow(project_id, translation_id, id string, params *phraseapp.VersionShowParams) (*phraseapp.TranslationVersionWithUser, error) {
+	return nil, notImplemented("VersionShow")
+}
+
+func (f *Fake) VersionsList(project_id, translation_id string, page, perPage int, params *phraseapp.VersionsListParams) ([]*phraseapp.TranslationVersion, error) {
+	return nil, notImplemented("VersionsList")
+}
+
+func (f *Fake) WebhookCreate(project_id string, params *phraseapp.WebhookParams) (*phraseapp.Webhook, error) {
+	return nil, notImplemented("WebhookCreate")
+}
+
+func (f *Fake) WebhookDelete(project_id, id string) error {
+	return notImplemented("WebhookDelete")
+}
+
+func (f *Fake) WebhookShow(project_id, id string) (*phraseapp.Webhook, error) {
+	return nil, notImplemented("WebhookShow")
+}
+
+func (f *Fake) WebhookTest(project_id, id string) error {
+	return notImplemented("WebhookTest")
+}
+
+func (f *Fake) WebhookUpdate(project_id, id string, params *phraseapp.WebhookParams) (*phraseapp.Webhook, error) {
+	return nil, notImplemented("WebhookUpdate")
+}
+
+func (f *Fake) WebhooksList(project_id string, page, perPage int) ([]*phraseapp.Webhook, error) {
+	return nil, notImplemented("WebhooksList")
+}
@@ -0,0 +1,339 @@
+package phraseapptest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/phrase/phraseapp-go/phraseapp"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// UploadCreate imports the file synchronously, so the returned upload is
+// already in state "success". Without a LocaleID the locale is taken from
+// the root of a yml file and created if it doesn't exist. The formats
+// phraseapp.FormatYML, FormatNestedJSON and FormatSimpleJSON are supported,
+// nested keys are joined with dots.
+func (f *Fake) UploadCreate(project_id string, params *phraseapp.UploadParams) (*phraseapp.Upload, error) {
+	var content []byte
+	if params.File != nil {
+		var err error
+		if content, err = ioutil.ReadFile(*params.File); err != nil {
+			return nil, err
+		}
+	}
+	return f.upload(project_id, params, content)
+}
+
+func (f *Fake) upload(project_id string, params *phraseapp.UploadParams, content []byte) (*phraseapp.Upload, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return nil, err
+	}
+	if params.File == nil {
+		return nil, invalid("Upload", "file", "can't be blank")
+	}
+
+	format := phraseapp.FileFormat(p.MainFormat)
+	if params.FileFormat != nil {
+		format = *params.FileFormat
+	}
+	root, translations, err := decodeFile(format, content)
+	if err != nil {
+		return nil, invalid("Upload", "file", err.Error())
+	}
+
+	u := &phraseapp.Upload{
+		ID:        f.newID(),
+		Filename:  filepath.Base(*params.File),
+		Format:    string(format),
+		State:     phraseapp.UploadStateSuccess,
+		CreatedAt: now(),
+		UpdatedAt: now(),
+	}
+
+	var l *phraseapp.LocaleDetails
+	switch {
+	case params.LocaleID != nil:
+		if l, err = p.locale(*params.LocaleID); err != nil {
+			return nil, invalid("Upload", "locale_id", "is invalid")
+		}
+	case root != "":
+		if l, err = p.locale(root); err != nil {
+			l = &phraseapp.LocaleDetails{}
+			l.ID = f.newID()
+			l.Name, l.Code = root, root
+			l.CreatedAt, l.UpdatedAt = now(), now()
+			l.Default = len(p.locales) == 0
+			p.locales = append(p.locales, l)
+			u.Summary.LocalesCreated++
+		}
+	default:
+		return nil, invalid("Upload", "locale_id", "can't be blank")
+	}
+
+	var tags []string
+	tagCount := len(p.tags)
+	if params.Tags != nil {
+		tags = p.addTags(*params.Tags)
+	}
+	if params.SkipUploadTags == nil || !*params.SkipUploadTags {
+		u.Tag = strings.TrimSuffix(u.Filename, filepath.Ext(u.Filename)) + "-" + u.ID[24:]
+		tags = append(tags, p.addTags(u.Tag)...)
+	}
+	u.Summary.TagsCreated = int64(len(p.tags) - tagCount)
+
+	update := params.UpdateTranslations != nil && *params.UpdateTranslations
+	unverified := params.SkipUnverification == nil || !*params.SkipUnverification
+	names := make([]string, 0, len(translations))
+	for name := range translations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		k := p.keyByName(name)
+		if k == nil {
+			k = &phraseapp.TranslationKeyDetails{}
+			k.ID = f.newID()
+			k.Name = name
+			k.DataType = "string"
+			k.Tags = []string{}
+			k.CreatedAt, k.UpdatedAt = now(), now()
+			p.keys = append(p.keys, k)
+			u.Summary.TranslationKeysCreated++
+		}
+		k.Tags = mergeTags(k.Tags, tags)
+
+		content := translations[name]
+		t := p.translationOf(k.ID, l.ID)
+		switch {
+		case t == nil:
+			t = p.newTranslation(f.newID(), k, l)
+			u.Summary.TranslationsCreated++
+		case update && t.Content != content:
+			u.Summary.TranslationsUpdated++
+		default:
+			continue
+		}
+		unverify := unverified && !l.Main && !l.Default
+		p.applyTranslationParams(t, &phraseapp.TranslationUpdateParams{Content: &content, Unverified: &unverify})
+	}
+	p.uploads = append(p.uploads, u)
+
+	upload := *u
+	return &upload, nil
+}
+
+func (f *Fake) UploadShow(project_id, id string, params *phraseapp.UploadShowParams) (*phraseapp.Upload, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range p.uploads {
+		if u.ID == id {
+			upload := *u
+			return &upload, nil
+		}
+	}
+	return nil, notFound()
+}
+
+func (f *Fake) UploadsList(project_id string, page, perPage int, params *phraseapp.UploadsListParams) ([]*phraseapp.Upload, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return nil, err
+	}
+	start, end := paginate(len(p.uploads), page, perPage)
+	list := []*phraseapp.Upload{}
+	for _, u := range p.uploads[start:end] {
+		upload := *u
+		list = append(list, &upload)
+	}
+	return list, nil
+}
+
+// LocaleDownload renders the translations of a locale in one of the
+// supported formats, defaulting to the project's main format.
+func (f *Fake) LocaleDownload(project_id, id string, params *phraseapp.LocaleDownloadParams) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.call(); err != nil {
+		return nil, err
+	}
+
+	p, err := f.project(project_id)
+	if err != nil {
+		return nil, err
+	}
+	l, err := p.locale(id)
+	if err != nil {
+		return nil, err
+	}
+
+	format := phraseapp.FileFormat(p.MainFormat)
+	if params.FileFormat != nil {
+		format = *params.FileFormat
+	}
+	var tags []string
+	if params.Tags != nil {
+		tags = strings.Split(*params.Tags, ",")
+	}
+	if params.Tag != nil {
+		tags = append(tags, *params.Tag)
+	}
+	includeEmpty := params.IncludeEmptyTranslations != nil && *params.IncludeEmptyTranslations
+	skipUnverified := params.SkipUnverifiedTranslations != nil && *params.SkipUnverifiedTranslations
+
+	translations := map[string]string{}
+	for _, k := range p.keys {
+		if !hasAnyTag(k.Tags, tags) {
+			continue
+		}
+		t := p.translationOf(k.ID, l.ID)
+		switch {
+		case t != nil && t.Content != "" && !t.Excluded && !(skipUnverified && t.Unverified):
+			translations[k.Name] = t.Content
+		case includeEmpty:
+			translations[k.Name] = ""
+		}
+	}
+
+	b, err := encodeFile(format, l.Code, translations)
+	if err != nil {
+		return nil, invalid("Locale", "file_format", err.Error())
+	}
+	return b, nil
+}
+
+func hasAnyTag(keyTags, tags []string) bool {
+	if len(tags) == 0 {
+		return true
+	}
+	for _, t := range tags {
+		if contains(keyTags, strings.TrimSpace(t)) {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeFile returns the flattened translations of a file and, for yml, its
+// root key.
+func decodeFile(format phraseapp.FileFormat, content []byte) (string, map[string]string, error) {
+	translations := map[string]string{}
+	switch format {
+	case phraseapp.FormatYML, "":
+		var doc map[string]interface{}
+		if err := yaml.Unmarshal(content, &doc); err != nil {
+			return "", nil, err
+		}
+		if len(doc) != 1 {
+			return "", nil, fmt.Errorf("must have the locale code as its only root key")
+		}
+		for root, v := range doc {
+			flatten("", v, translations)
+			return root, translations, nil
+		}
+	case phraseapp.FormatNestedJSON, phraseapp.FormatSimpleJSON:
+		var doc map[string]interface{}
+		if err := json.Unmarshal(content, &doc); err != nil {
+			return "", nil, err
+		}
+		flatten("", doc, translations)
+		return "", translations, nil
+	}
+	return "", nil, fmt.Errorf("has unsupported format %q", format)
+}
+
+func flatten(prefix string, v interface{}, translations map[string]string) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, child := range v {
+			flatten(joinKey(prefix, k), child, translations)
+		}
+	case map[interface{}]interface{}:
+		for k, child := range v {
+			flatten(joinKey(prefix, fmt.Sprint(k)), child, translations)
+		}
+	case nil:
+		translations[prefix] = ""
+	default:
+		translations[prefix] = fmt.Sprint(v)
+	}
+}
+
+func joinKey(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "." + key
+}
+
+func encodeFile(format phraseapp.FileFormat, code string, translations map[string]string) ([]byte, error) {
+	switch format {
+	case phraseapp.FormatYML, "":
+		return yaml.Marshal(map[string]interface{}{code: nest(translations)})
+	case phraseapp.FormatNestedJSON:
+		return json.MarshalIndent(nest(translations), "", "  ")
+	case phraseapp.FormatSimpleJSON:
+		return json.MarshalIndent(translations, "", "  ")
+	}
+	return nil, fmt.Errorf("is not supported: %q", format)
+}
+
+// nest turns dotted key names into nested maps. A key that is also the
+// prefix of other keys keeps its flat name.
+func nest(translations map[string]string) map[string]interface{} {
+	names := make([]string, 0, len(translations))
+	for name := range translations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	root := map[string]interface{}{}
+	for _, name := range names {
+		node := root
+		parts := strings.Split(name, ".")
+		for _, part := range parts[:len(parts)-1] {
+			child, ok := node[part].(map[string]interface{})
+			if !ok {
+				if _, taken := node[part]; taken {
+					node = nil
+					break
+				}
+				child = map[string]interface{}{}
+				node[part] = child
+			}
+			node = child
+		}
+		last := parts[len(parts)-1]
+		if _, isMap := node[last].(map[string]interface{}); node == nil || isMap {
+			root[name] = translations[name]
+			continue
+		}
+		node[last] = translations[name]
+	}
+	return root
+}
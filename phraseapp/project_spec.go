@@ -0,0 +1,617 @@
+package phraseapp
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ProjectSpec describes the desired state of a project's locales, tags,
+// blacklisted keys, webhooks and styleguides. A section that is omitted from
+// the spec is left untouched; a section that is given (even empty) is
+// authoritative and remote entries missing from it are deleted.
+type ProjectSpec struct {
+	Locales         []*LocaleSpec     `yaml:"locales"`
+	Tags            []string          `yaml:"tags"`
+	BlacklistedKeys []string          `yaml:"blacklisted_keys"`
+	Webhooks        []*WebhookSpec    `yaml:"webhooks"`
+	Styleguides     []*StyleguideSpec `yaml:"styleguides"`
+}
+
+// LocaleSpec is the desired state of a locale, identified by its name.
+type LocaleSpec struct {
+	Name    string `yaml:"name"`
+	Code    string `yaml:"code"`
+	Default *bool  `yaml:"default"`
+	Main    *bool  `yaml:"main"`
+	Rtl     *bool  `yaml:"rtl"`
+}
+
+// WebhookSpec is the desired state of a webhook, identified by its callback URL.
+type WebhookSpec struct {
+	CallbackUrl string   `yaml:"callback_url"`
+	Description *string  `yaml:"description"`
+	Events      []string `yaml:"events"`
+	Active      *bool    `yaml:"active"`
+}
+
+// StyleguideSpec is the desired state of a styleguide, identified by its title.
+type StyleguideSpec struct {
+	Title              string  `yaml:"title"`
+	Audience           *string `yaml:"audience"`
+	Business           *string `yaml:"business"`
+	CompanyBranding    *string `yaml:"company_branding"`
+	Formatting         *string `yaml:"formatting"`
+	GlossaryTerms      *string `yaml:"glossary_terms"`
+	GrammarConsistency *string `yaml:"grammar_consistency"`
+	GrammaticalPerson  *string `yaml:"grammatical_person"`
+	LiteralTranslation *string `yaml:"literal_translation"`
+	OverallTone        *string `yaml:"overall_tone"`
+	Samples            *string `yaml:"samples"`
+	TargetAudience     *string `yaml:"target_audience"`
+	VocabularyType     *string `yaml:"vocabulary_type"`
+}
+
+// ReadProjectSpec reads a project spec from the YAML file at path.
+func ReadProjectSpec(path string) (*ProjectSpec, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseProjectSpec(content)
+}
+
+// ParseProjectSpec parses a YAML project spec. Unknown keys are rejected.
+func ParseProjectSpec(content []byte) (*ProjectSpec, error) {
+	spec := new(ProjectSpec)
+	if err := yaml.UnmarshalStrict(content, spec); err != nil {
+		return nil, err
+	}
+	return spec, spec.validate()
+}
+
+func (spec *ProjectSpec) validate() error {
+	seen := map[string]bool{}
+	check := func(kind, name string) error {
+		if name == "" {
+			return fmt.Errorf("project spec: %s without identifier", kind)
+		}
+		if seen[kind+"\x00"+name] {
+			return fmt.Errorf("project spec: duplicate %s %q", kind, name)
+		}
+		seen[kind+"\x00"+name] = true
+		return nil
+	}
+
+	for _, l := range spec.Locales {
+		if err := check("locale", l.Name); err != nil {
+			return err
+		}
+	}
+	for _, t := range spec.Tags {
+		if err := check("tag", t); err != nil {
+			return err
+		}
+	}
+	for _, k := range spec.BlacklistedKeys {
+		if err := check("blacklisted key", k); err != nil {
+			return err
+		}
+	}
+	for _, w := range spec.Webhooks {
+		if err := check("webhook", w.CallbackUrl); err != nil {
+			return err
+		}
+	}
+	for _, s := range spec.Styleguides {
+		if err := check("styleguide", s.Title); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PlanAction is the kind of change a PlanOperation performs.
+type PlanAction string
+
+const (
+	PlanCreate PlanAction = "create"
+	PlanUpdate PlanAction = "update"
+	PlanDelete PlanAction = "delete"
+	PlanResend PlanAction = "resend"
+)
+
+// PlanChange is a single attribute change of an update or create operation.
+type PlanChange struct {
+	Field string
+	Old   string
+	New   string
+}
+
+// PlanOperation is a single create, update or delete of a remote resource.
+type PlanOperation struct {
+	Action   PlanAction
+	Resource string
+	Name     string
+	ID       string
+	Changes  []PlanChange
+
+	apply func(client *Client) error
+}
+
+// ProjectPlan is the list of operations needed to bring a project in line
+// with a ProjectSpec.
+type ProjectPlan struct {
+	ProjectID  string
+	Operations []*PlanOperation
+}
+
+// Empty reports whether the project already matches the spec.
+func (plan *ProjectPlan) Empty() bool {
+	return len(plan.Operations) == 0
+}
+
+// String renders the plan in a terraform like format.
+func (plan *ProjectPlan) String() string {
+	if plan.Empty() {
+		return "No changes. Project matches the spec.\n"
+	}
+	return renderOperations(plan.Operations)
+}
+
+// Apply executes the operations of the plan in order. It stops at the first
+// failing operation.
+func (plan *ProjectPlan) Apply(client *Client) error {
+	return applyOperations(client, plan.Operations)
+}
+
+var planSymbols = map[PlanAction]string{
+	PlanCreate: "+",
+	PlanUpdate: "~",
+	PlanDelete: "-",
+	PlanResend: ">",
+}
+
+func renderOperations(ops []*PlanOperation) string {
+	buf := new(bytes.Buffer)
+	counts := map[PlanAction]int{}
+	for _, op := range ops {
+		counts[op.Action]++
+
+		fmt.Fprintf(buf, "  %s %s %q", planSymbols[op.Action], op.Resource, op.Name)
+		if op.ID != "" {
+			fmt.Fprintf(buf, " (id: %s)", op.ID)
+		}
+		fmt.Fprintln(buf)
+
+		for _, c := range op.Changes {
+			if op.Action == PlanCreate {
+				fmt.Fprintf(buf, "      %s: %q\n", c.Field, c.New)
+			} else {
+				fmt.Fprintf(buf, "      %s: %q => %q\n", c.Field, c.Old, c.New)
+			}
+		}
+	}
+	fmt.Fprintf(buf, "\nPlan: %d to create, %d to update, %d to delete", counts[PlanCreate], counts[PlanUpdate], counts[PlanDelete])
+	if counts[PlanResend] > 0 {
+		fmt.Fprintf(buf, ", %d to resend", counts[PlanResend])
+	}
+	fmt.Fprintln(buf, ".")
+	return buf.String()
+}
+
+func applyOperations(client *Client, ops []*PlanOperation) error {
+	for _, op := range ops {
+		if err := op.apply(client); err != nil {
+			return fmt.Errorf("%s %s %q: %s", op.Action, op.Resource, op.Name, err)
+		}
+	}
+	return nil
+}
+
+// Plan compares the spec against the current state of the project and
+// returns the operations needed to reconcile them. Nothing is changed
+// remotely; call Apply on the returned plan to do so.
+func Plan(client *Client, projectID string, spec *ProjectSpec) (*ProjectPlan, error) {
+	plan := &ProjectPlan{ProjectID: projectID}
+
+	if spec.Locales != nil {
+		if err := plan.addLocales(client, spec.Locales); err != nil {
+			return nil, err
+		}
+	}
+	if spec.Tags != nil {
+		if err := plan.addTags(client, spec.Tags); err != nil {
+			return nil, err
+		}
+	}
+	if spec.BlacklistedKeys != nil {
+		if err := plan.addBlacklistedKeys(client, spec.BlacklistedKeys); err != nil {
+			return nil, err
+		}
+	}
+	if spec.Webhooks != nil {
+		if err := plan.addWebhooks(client, spec.Webhooks); err != nil {
+			return nil, err
+		}
+	}
+	if spec.Styleguides != nil {
+		if err := plan.addStyleguides(client, spec.Styleguides); err != nil {
+			return nil, err
+		}
+	}
+
+	return plan, nil
+}
+
+const specPerPage = 100
+
+func (plan *ProjectPlan) addLocales(client *Client, specs []*LocaleSpec) error {
+	remote := map[string]*Locale{}
+	for page := 1; ; page++ {
+		list, err := client.LocalesList(plan.ProjectID, page, specPerPage, &LocalesListParams{})
+		if err != nil {
+			return err
+		}
+		for _, l := range list {
+			remote[l.Name] = l
+		}
+		if len(list) < specPerPage {
+			break
+		}
+	}
+
+	projectID := plan.ProjectID
+	for _, spec := range specs {
+		spec := spec
+		params := new(LocaleParams)
+		cur, found := remote[spec.Name]
+		delete(remote, spec.Name)
+		if !found {
+			cur = new(Locale)
+		}
+
+		var changes []PlanChange
+		if !found {
+			params.Name = &spec.Name
+			changes = append(changes, PlanChange{Field: "name", New: spec.Name})
+		}
+		if spec.Code != "" && (!found || cur.Code != spec.Code) {
+			params.Code = &spec.Code
+			changes = append(changes, specChange(found, "code", cur.Code, spec.Code))
+		}
+		if spec.Default != nil && (!found || cur.Default != *spec.Default) {
+			params.Default = spec.Default
+			changes = append(changes, specChange(found, "default", strconv.FormatBool(cur.Default), strconv.FormatBool(*spec.Default)))
+		}
+		if spec.Main != nil && (!found || cur.Main != *spec.Main) {
+			params.Main = spec.Main
+			changes = append(changes, specChange(found, "main", strconv.FormatBool(cur.Main), strconv.FormatBool(*spec.Main)))
+		}
+		if spec.Rtl != nil && (!found || cur.Rtl != *spec.Rtl) {
+			params.Rtl = spec.Rtl
+			changes = append(changes, specChange(found, "rtl", strconv.FormatBool(cur.Rtl), strconv.FormatBool(*spec.Rtl)))
+		}
+
+		switch {
+		case !found:
+			plan.add(&PlanOperation{Action: PlanCreate, Resource: "locale", Name: spec.Name, Changes: changes,
+				apply: func(client *Client) error {
+					_, err := client.LocaleCreate(projectID, params)
+					return err
+				}})
+		case len(changes) > 0:
+			id := cur.ID
+			plan.add(&PlanOperation{Action: PlanUpdate, Resource: "locale", Name: spec.Name, ID: id, Changes: changes,
+				apply: func(client *Client) error {
+					_, err := client.LocaleUpdate(projectID, id, params)
+					return err
+				}})
+		}
+	}
+
+	leftover := make([]string, 0, len(remote))
+	for name := range remote {
+		leftover = append(leftover, name)
+	}
+	sort.Strings(leftover)
+	for _, name := range leftover {
+		id := remote[name].ID
+		plan.add(&PlanOperation{Action: PlanDelete, Resource: "locale", Name: name, ID: id,
+			apply: func(client *Client) error {
+				return client.LocaleDelete(projectID, id, &LocaleDeleteParams{})
+			}})
+	}
+	return nil
+}
+
+func (plan *ProjectPlan) addTags(client *Client, specs []string) error {
+	remote := map[string]*Tag{}
+	for page := 1; ; page++ {
+		list, err := client.TagsList(plan.ProjectID, page, specPerPage, &TagsListParams{})
+		if err != nil {
+			return err
+		}
+		for _, t := range list {
+			remote[t.Name] = t
+		}
+		if len(list) < specPerPage {
+			break
+		}
+	}
+
+	projectID := plan.ProjectID
+	for _, name := range specs {
+		name := name
+		if _, found := remote[name]; found {
+			delete(remote, name)
+			continue
+		}
+		plan.add(&PlanOperation{Action: PlanCreate, Resource: "tag", Name: name,
+			apply: func(client *Client) error {
+				_, err := client.TagCreate(projectID, &TagParams{Name: &name})
+				return err
+			}})
+	}
+
+	leftover := make([]string, 0, len(remote))
+	for name := range remote {
+		leftover = append(leftover, name)
+	}
+	sort.Strings(leftover)
+	for _, name := range leftover {
+		name := name
+		plan.add(&PlanOperation{Action: PlanDelete, Resource: "tag", Name: name,
+			apply: func(client *Client) error {
+				return client.TagDelete(projectID, name, &TagDeleteParams{})
+			}})
+	}
+	return nil
+}
+
+func (plan *ProjectPlan) addBlacklistedKeys(client *Client, specs []string) error {
+	remote := map[string]*BlacklistedKey{}
+	for page := 1; ; page++ {
+		list, err := client.BlacklistedKeysList(plan.ProjectID, page, specPerPage)
+		if err != nil {
+			return err
+		}
+		for _, k := range list {
+			remote[k.Name] = k
+		}
+		if len(list) < specPerPage {
+			break
+		}
+	}
+
+	projectID := plan.ProjectID
+	for _, name := range specs {
+		name := name
+		if _, found := remote[name]; found {
+			delete(remote, name)
+			continue
+		}
+		plan.add(&PlanOperation{Action: PlanCreate, Resource: "blacklisted_key", Name: name,
+			apply: func(client *Client) error {
+				_, err := client.BlacklistedKeyCreate(projectID, &BlacklistedKeyParams{Name: &name})
+				return err
+			}})
+	}
+
+	leftover := make([]string, 0, len(remote))
+	for name := range remote {
+		leftover = append(leftover, name)
+	}
+	sort.Strings(leftover)
+	for _, name := range leftover {
+		id := remote[name].ID
+		plan.add(&PlanOperation{Action: PlanDelete, Resource: "blacklisted_key", Name: name, ID: id,
+			apply: func(client *Client) error {
+				return client.BlacklistedKeyDelete(projectID, id)
+			}})
+	}
+	return nil
+}
+
+func (plan *ProjectPlan) addWebhooks(client *Client, specs []*WebhookSpec) error {
+	remote := map[string]*Webhook{}
+	for page := 1; ; page++ {
+		list, err := client.WebhooksList(plan.ProjectID, page, specPerPage)
+		if err != nil {
+			return err
+		}
+		for _, w := range list {
+			remote[w.CallbackUrl] = w
+		}
+		if len(list) < specPerPage {
+			break
+		}
+	}
+
+	projectID := plan.ProjectID
+	for _, spec := range specs {
+		spec := spec
+		params := new(WebhookParams)
+		cur, found := remote[spec.CallbackUrl]
+		delete(remote, spec.CallbackUrl)
+		if !found {
+			cur = new(Webhook)
+		}
+
+		var changes []PlanChange
+		if !found {
+			params.CallbackUrl = &spec.CallbackUrl
+			changes = append(changes, PlanChange{Field: "callback_url", New: spec.CallbackUrl})
+		}
+		if spec.Description != nil && (!found || cur.Description != *spec.Description) {
+			params.Description = spec.Description
+			changes = append(changes, specChange(found, "description", cur.Description, *spec.Description))
+		}
+		if spec.Events != nil {
+			want := sortedCopy(spec.Events)
+			if have := sortedCopy(webhookEventNames(cur.Events)); !found || strings.Join(have, ",") != strings.Join(want, ",") {
+				events := strings.Join(want, ",")
+				params.Events = ParseWebhookEvents(events)
+				changes = append(changes, specChange(found, "events", strings.Join(have, ","), events))
+			}
+		}
+		if spec.Active != nil && (!found || cur.Active != *spec.Active) {
+			params.Active = spec.Active
+			changes = append(changes, specChange(found, "active", strconv.FormatBool(cur.Active), strconv.FormatBool(*spec.Active)))
+		}
+
+		switch {
+		case !found:
+			plan.add(&PlanOperation{Action: PlanCreate, Resource: "webhook", Name: spec.CallbackUrl, Changes: changes,
+				apply: func(client *Client) error {
+					_, err := client.WebhookCreate(projectID, params)
+					return err
+				}})
+		case len(changes) > 0:
+			id := cur.ID
+			plan.add(&PlanOperation{Action: PlanUpdate, Resource: "webhook", Name: spec.CallbackUrl, ID: id, Changes: changes,
+				apply: func(client *Client) error {
+					_, err := client.WebhookUpdate(projectID, id, params)
+					return err
+				}})
+		}
+	}
+
+	leftover := make([]string, 0, len(remote))
+	for callbackUrl := range remote {
+		leftover = append(leftover, callbackUrl)
+	}
+	sort.Strings(leftover)
+	for _, callbackUrl := range leftover {
+		id := remote[callbackUrl].ID
+		plan.add(&PlanOperation{Action: PlanDelete, Resource: "webhook", Name: callbackUrl, ID: id,
+			apply: func(client *Client) error {
+				return client.WebhookDelete(projectID, id)
+			}})
+	}
+	return nil
+}
+
+func (plan *ProjectPlan) addStyleguides(client *Client, specs []*StyleguideSpec) error {
+	remote := map[string]*Styleguide{}
+	for page := 1; ; page++ {
+		list, err := client.StyleguidesList(plan.ProjectID, page, specPerPage)
+		if err != nil {
+			return err
+		}
+		for _, s := range list {
+			remote[s.Title] = s
+		}
+		if len(list) < specPerPage {
+			break
+		}
+	}
+
+	projectID := plan.ProjectID
+	for _, spec := range specs {
+		spec := spec
+		cur := new(StyleguideDetails)
+		short, found := remote[spec.Title]
+		delete(remote, spec.Title)
+		if found {
+			// the list endpoint only returns id and title
+			details, err := client.StyleguideShow(projectID, short.ID)
+			if err != nil {
+				return err
+			}
+			cur = details
+		}
+
+		params := new(StyleguideParams)
+		var changes []PlanChange
+		if !found {
+			params.Title = &spec.Title
+			changes = append(changes, PlanChange{Field: "title", New: spec.Title})
+		}
+		fields := []struct {
+			name  string
+			want  *string
+			have  string
+			param **string
+		}{
+			{"audience", spec.Audience, cur.Audience, &params.Audience},
+			{"business", spec.Business, cur.Business, &params.Business},
+			{"company_branding", spec.CompanyBranding, cur.CompanyBranding, &params.CompanyBranding},
+			{"formatting", spec.Formatting, cur.Formatting, &params.Formatting},
+			{"glossary_terms", spec.GlossaryTerms, cur.GlossaryTerms, &params.GlossaryTerms},
+			{"grammar_consistency", spec.GrammarConsistency, cur.GrammarConsistency, &params.GrammarConsistency},
+			{"grammatical_person", spec.GrammaticalPerson, cur.GrammaticalPerson, &params.GrammaticalPerson},
+			{"literal_translation", spec.LiteralTranslation, cur.LiteralTranslation, &params.LiteralTranslation},
+			{"overall_tone", spec.OverallTone, cur.OverallTone, &params.OverallTone},
+			{"samples", spec.Samples, cur.Samples, &params.Samples},
+			{"target_audience", spec.TargetAudience, cur.TargetAudience, &params.TargetAudience},
+			{"vocabulary_type", spec.VocabularyType, cur.VocabularyType, &params.VocabularyType},
+		}
+		for _, f := range fields {
+			if f.want != nil && (!found || f.have != *f.want) {
+				*f.param = f.want
+				changes = append(changes, specChange(found, f.name, f.have, *f.want))
+			}
+		}
+
+		switch {
+		case !found:
+			plan.add(&PlanOperation{Action: PlanCreate, Resource: "styleguide", Name: spec.Title, Changes: changes,
+				apply: func(client *Client) error {
+					_, err := client.StyleguideCreate(projectID, params)
+					return err
+				}})
+		case len(changes) > 0:
+			id := short.ID
+			plan.add(&PlanOperation{Action: PlanUpdate, Resource: "styleguide", Name: spec.Title, ID: id, Changes: changes,
+				apply: func(client *Client) error {
+					_, err := client.StyleguideUpdate(projectID, id, params)
+					return err
+				}})
+		}
+	}
+
+	leftover := make([]string, 0, len(remote))
+	for title := range remote {
+		leftover = append(leftover, title)
+	}
+	sort.Strings(leftover)
+	for _, title := range leftover {
+		id := remote[title].ID
+		plan.add(&PlanOperation{Action: PlanDelete, Resource: "styleguide", Name: title, ID: id,
+			apply: func(client *Client) error {
+				return client.StyleguideDelete(projectID, id)
+			}})
+	}
+	return nil
+}
+
+func (plan *ProjectPlan) add(op *PlanOperation) {
+	plan.Operations = append(plan.Operations, op)
+}
+
+func specChange(found bool, field, old, new string) PlanChange {
+	if !found {
+		old = ""
+	}
+	return PlanChange{Field: field, Old: old, New: new}
+}
+
+func sortedCopy(in []string) []string {
+	out := append([]string{}, in...)
+	sort.Strings(out)
+	return out
+}
+
+func webhookEventNames(events []WebhookEvent) []string {
+	names := make([]string, 0, len(events))
+	for _, e := range events {
+		names = append(names, string(e))
+	}
+	return names
+}
@@ -0,0 +1,98 @@
+package phraseapp
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+)
+
+const testProjectSpec = `
+locales:
+  - name: en
+    code: en-US
+    default: true
+  - name: de
+    code: de-DE
+tags: [release]
+webhooks:
+  - callback_url: https://example.com/hook
+    events: [uploads:create, locales:update]
+    active: true
+`
+
+func TestParseProjectSpec_Invalid(t *testing.T) {
+	_, err := ParseProjectSpec([]byte("locales:\n  - name: en\n    codee: en\n"))
+	if err == nil {
+		t.Errorf("expected an error for an unknown key, got none")
+	}
+
+	_, err = ParseProjectSpec([]byte("tags: [a, a]\n"))
+	if err == nil || err.Error() != `project spec: duplicate tag "a"` {
+		t.Errorf("expected duplicate tag error, got %v", err)
+	}
+}
+
+func TestPlanAndApply(t *testing.T) {
+	responses := map[string]string{
+		"GET /v2/projects/p1/locales":  `[{"id":"l1","name":"en","code":"en-GB","default":true},{"id":"l2","name":"fr","code":"fr"}]`,
+		"GET /v2/projects/p1/tags":     `[{"name":"release"},{"name":"obsolete"}]`,
+		"GET /v2/projects/p1/webhooks": `[{"id":"w1","callback_url":"https://example.com/hook","events":["locales:update","uploads:create"],"active":true}]`,
+	}
+	var mutations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Method + " " + r.URL.Path
+		if body, found := responses[key]; found {
+			io.WriteString(w, body)
+			return
+		}
+		mutations = append(mutations, key)
+		switch r.Method {
+		case "POST":
+			w.WriteHeader(http.StatusCreated)
+			io.WriteString(w, "{}")
+		case "PATCH":
+			io.WriteString(w, "{}")
+		case "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Credentials{Host: server.URL, Token: "secret"}, false)
+	spec, err := ParseProjectSpec([]byte(testProjectSpec))
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+
+	plan, err := Plan(client, "p1", spec)
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+
+	expPlan := `  ~ locale "en" (id: l1)
+      code: "en-GB" => "en-US"
+  + locale "de"
+      name: "de"
+      code: "de-DE"
+  - locale "fr" (id: l2)
+  - tag "obsolete"
+
+Plan: 1 to create, 1 to update, 2 to delete.
+`
+	if plan.String() != expPlan {
+		t.Errorf("expected plan\n%s\ngot\n%s", expPlan, plan)
+	}
+
+	if err := plan.Apply(client); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+
+	sort.Strings(mutations)
+	expMutations := "DELETE /v2/projects/p1/locales/l2,DELETE /v2/projects/p1/tags/obsolete,PATCH /v2/projects/p1/locales/l1,POST /v2/projects/p1/locales"
+	if got := strings.Join(mutations, ","); got != expMutations {
+		t.Errorf("expected requests %q, got %q", expMutations, got)
+	}
+}
@@ -25,9 +25,9 @@ func handleResponseStatus(resp *http.Response, expectedStatus int) error {
 		}
 		return e
 	case http.StatusUnauthorized:
-		return fmt.Errorf("%d - %s\nThe credentials you provided are invalid.%s", status, http.StatusText(status), further())
+		return ErrUnauthorized{newResponseInfo(resp)}
 	case http.StatusForbidden:
-		return fmt.Errorf("%d - %s\nYou are not authorized to perform the requested action on the requested resource. Check if your provided access_token has the correct scope.%s", status, http.StatusText(status), further())
+		return ErrForbidden{newResponseInfo(resp)}
 	case http.StatusNotFound:
 		var rsp struct {
 			Message string `json:"message"`
@@ -52,6 +52,9 @@ func handleResponseStatus(resp *http.Response, expectedStatus int) error {
 		}
 		return e
 	default:
-		return fmt.Errorf("Unexpected HTTP Status Code (%d %s) received; expected %d %s.%s", status, http.StatusText(status), expectedStatus, http.StatusText(expectedStatus), further())
+		if status >= 500 {
+			return ErrServer{newResponseInfo(resp), expectedStatus}
+		}
+		return ErrUnexpectedStatus{newResponseInfo(resp), expectedStatus}
 	}
 }
@@ -0,0 +1,398 @@
+package phraseapp
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Roster describes who should have access to an account and with which
+// role, projects, locales and permissions.
+type Roster struct {
+	Members []*RosterEntry `yaml:"members"`
+}
+
+// RosterEntry is the desired access of a single user, identified by email.
+type RosterEntry struct {
+	Email       string            `yaml:"email"`
+	Role        string            `yaml:"role"`
+	ProjectIDs  []string          `yaml:"project_ids"`
+	LocaleIDs   []string          `yaml:"locale_ids"`
+	Permissions map[string]string `yaml:"permissions"`
+}
+
+// ReadRoster reads a roster from the YAML file at path.
+func ReadRoster(path string) (*Roster, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRoster(content)
+}
+
+// ParseRoster parses a YAML roster. Unknown keys are rejected.
+func ParseRoster(content []byte) (*Roster, error) {
+	roster := new(Roster)
+	if err := yaml.UnmarshalStrict(content, roster); err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	for _, e := range roster.Members {
+		email := strings.ToLower(e.Email)
+		switch {
+		case email == "":
+			return nil, fmt.Errorf("roster: member without email")
+		case e.Role == "":
+			return nil, fmt.Errorf("roster: member %q without role", e.Email)
+		case seen[email]:
+			return nil, fmt.Errorf("roster: duplicate member %q", e.Email)
+		}
+		seen[email] = true
+	}
+	return roster, nil
+}
+
+// RosterSyncOptions control how a roster is reconciled with an account.
+type RosterSyncOptions struct {
+	// RemoveUnlisted deletes members and pending invitations that are not
+	// part of the roster. Account owners are never removed.
+	RemoveUnlisted bool
+
+	// ResendAfter resends pending invitations that were last sent longer ago
+	// than the given duration. Zero disables resending.
+	ResendAfter time.Duration
+}
+
+// AccessRemoval records access a user loses when a roster plan is applied.
+type AccessRemoval struct {
+	Email      string
+	Account    bool     // whole account access is removed
+	ProjectIDs []string // projects the user loses access to
+}
+
+// RosterPlan is the list of operations needed to bring an account's members
+// and invitations in line with a Roster.
+type RosterPlan struct {
+	AccountID  string
+	Operations []*PlanOperation
+	Removals   []*AccessRemoval
+}
+
+// Empty reports whether the account already matches the roster.
+func (plan *RosterPlan) Empty() bool {
+	return len(plan.Operations) == 0
+}
+
+// String renders the plan in a terraform like format.
+func (plan *RosterPlan) String() string {
+	if plan.Empty() {
+		return "No changes. Account matches the roster.\n"
+	}
+	return renderOperations(plan.Operations)
+}
+
+// RemovalReport lists the access that is removed when the plan is applied.
+func (plan *RosterPlan) RemovalReport() string {
+	if len(plan.Removals) == 0 {
+		return "No access removed.\n"
+	}
+
+	buf := new(bytes.Buffer)
+	for _, r := range plan.Removals {
+		if r.Account {
+			fmt.Fprintf(buf, "%s: removed from account\n", r.Email)
+		} else {
+			fmt.Fprintf(buf, "%s: removed from projects %s\n", r.Email, strings.Join(r.ProjectIDs, ", "))
+		}
+	}
+	return buf.String()
+}
+
+// Apply executes the operations of the plan in order. It stops at the first
+// failing operation.
+func (plan *RosterPlan) Apply(client *Client) error {
+	return applyOperations(client, plan.Operations)
+}
+
+// PlanRoster compares the roster against the members and pending invitations
+// of the account and returns the operations needed to reconcile them.
+// Nothing is changed remotely; call Apply on the returned plan to do so.
+func PlanRoster(client *Client, accountID string, roster *Roster, opts RosterSyncOptions) (*RosterPlan, error) {
+	members := map[string]*Member{}
+	for page := 1; ; page++ {
+		list, err := client.MembersList(accountID, page, specPerPage)
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range list {
+			members[strings.ToLower(m.Email)] = m
+		}
+		if len(list) < specPerPage {
+			break
+		}
+	}
+
+	invitations := map[string]*Invitation{}
+	for page := 1; ; page++ {
+		list, err := client.InvitationsList(accountID, page, specPerPage)
+		if err != nil {
+			return nil, err
+		}
+		for _, i := range list {
+			if i.AcceptedAt == nil {
+				invitations[strings.ToLower(i.Email)] = i
+			}
+		}
+		if len(list) < specPerPage {
+			break
+		}
+	}
+
+	plan := &RosterPlan{AccountID: accountID}
+	for _, entry := range roster.Members {
+		email := strings.ToLower(entry.Email)
+		if m, found := members[email]; found {
+			delete(members, email)
+			plan.addMember(entry, m)
+		} else if i, found := invitations[email]; found {
+			delete(invitations, email)
+			plan.addInvitation(entry, i, opts.ResendAfter)
+		} else {
+			plan.addInvitation(entry, nil, 0)
+		}
+	}
+
+	if !opts.RemoveUnlisted {
+		return plan, nil
+	}
+
+	for _, email := range sortedMemberEmails(members) {
+		m := members[email]
+		if m.Role == "Owner" {
+			continue
+		}
+		id := m.ID
+		plan.Operations = append(plan.Operations, &PlanOperation{Action: PlanDelete, Resource: "member", Name: m.Email, ID: id,
+			apply: func(client *Client) error {
+				return client.MemberDelete(accountID, id)
+			}})
+		plan.Removals = append(plan.Removals, &AccessRemoval{Email: m.Email, Account: true, ProjectIDs: memberProjectIDs(m)})
+	}
+
+	for _, email := range sortedInvitationEmails(invitations) {
+		i := invitations[email]
+		id := i.ID
+		plan.Operations = append(plan.Operations, &PlanOperation{Action: PlanDelete, Resource: "invitation", Name: i.Email, ID: id,
+			apply: func(client *Client) error {
+				return client.InvitationDelete(accountID, id)
+			}})
+		plan.Removals = append(plan.Removals, &AccessRemoval{Email: i.Email, Account: true, ProjectIDs: invitationProjectIDs(i)})
+	}
+
+	return plan, nil
+}
+
+func (plan *RosterPlan) addMember(entry *RosterEntry, m *Member) {
+	var changes []PlanChange
+	if m.Role != entry.Role {
+		changes = append(changes, PlanChange{Field: "role", Old: m.Role, New: entry.Role})
+	}
+
+	curProjects := memberProjectIDs(m)
+	if entry.ProjectIDs != nil && !sameIDs(curProjects, entry.ProjectIDs) {
+		changes = append(changes, PlanChange{Field: "project_ids", Old: joinIDs(curProjects), New: joinIDs(entry.ProjectIDs)})
+	}
+
+	curLocales := []string{}
+	for _, p := range m.Projects {
+		for _, l := range p.Locales {
+			curLocales = append(curLocales, l.ID)
+		}
+	}
+	if entry.LocaleIDs != nil && !sameIDs(curLocales, entry.LocaleIDs) {
+		changes = append(changes, PlanChange{Field: "locale_ids", Old: joinIDs(curLocales), New: joinIDs(entry.LocaleIDs)})
+	}
+	if entry.Permissions != nil && !samePermissions(m.Permissions, entry.Permissions) {
+		changes = append(changes, PlanChange{Field: "permissions", Old: formatPermissions(m.Permissions), New: formatPermissions(entry.Permissions)})
+	}
+
+	if len(changes) == 0 {
+		return
+	}
+
+	accountID, id := plan.AccountID, m.ID
+	params := &MemberUpdateParams{Role: &entry.Role, Permissions: entry.Permissions}
+	if entry.ProjectIDs != nil {
+		if removed := subtractIDs(curProjects, entry.ProjectIDs); len(removed) > 0 {
+			plan.Removals = append(plan.Removals, &AccessRemoval{Email: m.Email, ProjectIDs: removed})
+		}
+		projectIDs := joinIDs(entry.ProjectIDs)
+		params.ProjectIDs = &projectIDs
+	}
+	if entry.LocaleIDs != nil {
+		localeIDs := joinIDs(entry.LocaleIDs)
+		params.LocaleIDs = &localeIDs
+	}
+	plan.Operations = append(plan.Operations, &PlanOperation{Action: PlanUpdate, Resource: "member", Name: m.Email, ID: id, Changes: changes,
+		apply: func(client *Client) error {
+			_, err := client.MemberUpdate(accountID, id, params)
+			return err
+		}})
+}
+
+func (plan *RosterPlan) addInvitation(entry *RosterEntry, i *Invitation, resendAfter time.Duration) {
+	accountID := plan.AccountID
+	projectIDs := joinIDs(entry.ProjectIDs)
+	localeIDs := joinIDs(entry.LocaleIDs)
+
+	if i == nil {
+		email := entry.Email
+		params := &InvitationCreateParams{Email: &email, Role: &entry.Role, Permissions: entry.Permissions}
+		changes := []PlanChange{{Field: "role", New: entry.Role}}
+		if entry.ProjectIDs != nil {
+			params.ProjectIDs = &projectIDs
+			changes = append(changes, PlanChange{Field: "project_ids", New: projectIDs})
+		}
+		if entry.LocaleIDs != nil {
+			params.LocaleIDs = &localeIDs
+			changes = append(changes, PlanChange{Field: "locale_ids", New: localeIDs})
+		}
+		plan.Operations = append(plan.Operations, &PlanOperation{Action: PlanCreate, Resource: "invitation", Name: entry.Email, Changes: changes,
+			apply: func(client *Client) error {
+				_, err := client.InvitationCreate(accountID, params)
+				return err
+			}})
+		return
+	}
+
+	var changes []PlanChange
+	if i.Role != entry.Role {
+		changes = append(changes, PlanChange{Field: "role", Old: i.Role, New: entry.Role})
+	}
+	curProjects := invitationProjectIDs(i)
+	if entry.ProjectIDs != nil && !sameIDs(curProjects, entry.ProjectIDs) {
+		changes = append(changes, PlanChange{Field: "project_ids", Old: joinIDs(curProjects), New: projectIDs})
+	}
+	curLocales := []string{}
+	for _, l := range i.Locales {
+		curLocales = append(curLocales, l.ID)
+	}
+	if entry.LocaleIDs != nil && !sameIDs(curLocales, entry.LocaleIDs) {
+		changes = append(changes, PlanChange{Field: "locale_ids", Old: joinIDs(curLocales), New: localeIDs})
+	}
+	if entry.Permissions != nil && !samePermissions(i.Permissions, entry.Permissions) {
+		changes = append(changes, PlanChange{Field: "permissions", Old: formatPermissions(i.Permissions), New: formatPermissions(entry.Permissions)})
+	}
+
+	id := i.ID
+	if len(changes) > 0 {
+		params := &InvitationUpdateParams{Role: &entry.Role, Permissions: entry.Permissions}
+		if entry.ProjectIDs != nil {
+			if removed := subtractIDs(curProjects, entry.ProjectIDs); len(removed) > 0 {
+				plan.Removals = append(plan.Removals, &AccessRemoval{Email: i.Email, ProjectIDs: removed})
+			}
+			params.ProjectIDs = &projectIDs
+		}
+		if entry.LocaleIDs != nil {
+			params.LocaleIDs = &localeIDs
+		}
+		plan.Operations = append(plan.Operations, &PlanOperation{Action: PlanUpdate, Resource: "invitation", Name: i.Email, ID: id, Changes: changes,
+			apply: func(client *Client) error {
+				_, err := client.InvitationUpdate(accountID, id, params)
+				return err
+			}})
+		return
+	}
+
+	if resendAfter <= 0 {
+		return
+	}
+	sentAt := i.UpdatedAt
+	if sentAt == nil {
+		sentAt = i.CreatedAt
+	}
+	if sentAt != nil && time.Since(*sentAt) > resendAfter {
+		plan.Operations = append(plan.Operations, &PlanOperation{Action: PlanResend, Resource: "invitation", Name: i.Email, ID: id,
+			Changes: []PlanChange{{Field: "last_sent", Old: sentAt.Format(time.RFC3339), New: "now"}},
+			apply: func(client *Client) error {
+				_, err := client.InvitationResend(accountID, id)
+				return err
+			}})
+	}
+}
+
+func memberProjectIDs(m *Member) []string {
+	ids := []string{}
+	for _, p := range m.Projects {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
+
+func invitationProjectIDs(i *Invitation) []string {
+	ids := []string{}
+	for _, p := range i.Projects {
+		ids = append(ids, p.ID)
+	}
+	return ids
+}
+
+func sameIDs(a, b []string) bool {
+	return joinIDs(a) == joinIDs(b)
+}
+
+// subtractIDs returns the ids of a that are not in b.
+func subtractIDs(a, b []string) []string {
+	keep := map[string]bool{}
+	for _, id := range b {
+		keep[id] = true
+	}
+	var out []string
+	for _, id := range sortedCopy(a) {
+		if !keep[id] {
+			out = append(out, id)
+		}
+	}
+	return out
+}
+
+func joinIDs(ids []string) string {
+	return strings.Join(sortedCopy(ids), ",")
+}
+
+func samePermissions(a, b map[string]string) bool {
+	return formatPermissions(a) == formatPermissions(b)
+}
+
+func formatPermissions(perms map[string]string) string {
+	pairs := make([]string, 0, len(perms))
+	for k, v := range perms {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func sortedMemberEmails(m map[string]*Member) []string {
+	emails := make([]string, 0, len(m))
+	for email := range m {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+	return emails
+}
+
+func sortedInvitationEmails(m map[string]*Invitation) []string {
+	emails := make([]string, 0, len(m))
+	for email := range m {
+		emails = append(emails, email)
+	}
+	sort.Strings(emails)
+	return emails
+}
@@ -0,0 +1,133 @@
+package phraseapp
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testRoster = `
+members:
+  - email: Dev@example.com
+    role: Developer
+    project_ids: [p1]
+  - email: pending@example.com
+    role: Translator
+    project_ids: [p2]
+  - email: new@example.com
+    role: Translator
+    project_ids: [p1, p2]
+`
+
+func TestPlanRoster(t *testing.T) {
+	sent := time.Now().Add(-30 * 24 * time.Hour).UTC().Format(time.RFC3339)
+	responses := map[string]string{
+		"GET /v2/accounts/a1/members": `[
+			{"id":"m1","email":"dev@example.com","role":"Developer","projects":[{"id":"p1"},{"id":"p2"}]},
+			{"id":"m2","email":"owner@example.com","role":"Owner"},
+			{"id":"m3","email":"gone@example.com","role":"Translator","projects":[{"id":"p1"}]}
+		]`,
+		"GET /v2/accounts/a1/invitations": `[
+			{"id":"i1","email":"pending@example.com","role":"Translator","projects":[{"id":"p2"}],"created_at":"` + sent + `"}
+		]`,
+	}
+	var mutations []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Method + " " + r.URL.Path
+		if body, found := responses[key]; found {
+			io.WriteString(w, body)
+			return
+		}
+		mutations = append(mutations, key)
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/resend"):
+			io.WriteString(w, "{}")
+		case r.Method == "POST":
+			w.WriteHeader(http.StatusCreated)
+			io.WriteString(w, "{}")
+		case r.Method == "PATCH":
+			io.WriteString(w, "{}")
+		case r.Method == "DELETE":
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Credentials{Host: server.URL, Token: "secret"}, false)
+	roster, err := ParseRoster([]byte(testRoster))
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+
+	plan, err := PlanRoster(client, "a1", roster, RosterSyncOptions{RemoveUnlisted: true, ResendAfter: 7 * 24 * time.Hour})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+
+	expReport := "dev@example.com: removed from projects p2\ngone@example.com: removed from account\n"
+	if report := plan.RemovalReport(); report != expReport {
+		t.Errorf("expected report %q, got %q", expReport, report)
+	}
+
+	if !strings.HasSuffix(plan.String(), "Plan: 1 to create, 1 to update, 1 to delete, 1 to resend.\n") {
+		t.Errorf("unexpected plan summary:\n%s", plan)
+	}
+
+	if err := plan.Apply(client); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+
+	sort.Strings(mutations)
+	expMutations := "DELETE /v2/accounts/a1/members/m3,PATCH /v2/accounts/a1/members/m1,POST /v2/accounts/a1/invitations,POST /v2/accounts/a1/invitations/i1/resend"
+	if got := strings.Join(mutations, ","); got != expMutations {
+		t.Errorf("expected requests %q, got %q", expMutations, got)
+	}
+}
+
+func TestPlanRosterMemberPermissions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/accounts/a1/members":
+			io.WriteString(w, `[
+				{"id":"m1","email":"dev@example.com","role":"Developer","permissions":{"create_upload":"true"}},
+				{"id":"m2","email":"same@example.com","role":"Developer","permissions":{"create_upload":"true"}}
+			]`)
+		default:
+			io.WriteString(w, "[]")
+		}
+	}))
+	defer server.Close()
+
+	client, _ := NewClient(Credentials{Host: server.URL, Token: "secret"}, false)
+	roster, err := ParseRoster([]byte(`
+members:
+  - email: dev@example.com
+    role: Developer
+    permissions:
+      create_upload: "false"
+  - email: same@example.com
+    role: Developer
+    permissions:
+      create_upload: "true"
+`))
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+
+	plan, err := PlanRoster(client, "a1", roster, RosterSyncOptions{})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if len(plan.Operations) != 1 {
+		t.Fatalf("expected a single update, got %d operations:\n%s", len(plan.Operations), plan)
+	}
+	op := plan.Operations[0]
+	exp := PlanChange{Field: "permissions", Old: "create_upload=true", New: "create_upload=false"}
+	if op.Action != PlanUpdate || op.Name != "dev@example.com" || len(op.Changes) != 1 || op.Changes[0] != exp {
+		t.Errorf("expected the permissions of dev@example.com to be updated, got %+v", op)
+	}
+}
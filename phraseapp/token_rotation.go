@@ -0,0 +1,265 @@
+package phraseapp
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// TokenDestination receives the token created by RotateAuthorization.
+type TokenDestination interface {
+	WriteToken(token string) error
+}
+
+// TokenFile writes the bare token to the file with the given path.
+type TokenFile string
+
+func (path TokenFile) WriteToken(token string) error {
+	return ioutil.WriteFile(string(path), []byte(token+"\n"), 0600)
+}
+
+// TokenEnvFile sets a variable in a dotenv style file, replacing an existing
+// assignment of the same variable or appending a new one.
+type TokenEnvFile struct {
+	Path     string
+	Variable string // defaults to PHRASEAPP_ACCESS_TOKEN
+}
+
+func (f TokenEnvFile) WriteToken(token string) error {
+	variable := f.Variable
+	if variable == "" {
+		variable = "PHRASEAPP_ACCESS_TOKEN"
+	}
+
+	content, err := ioutil.ReadFile(f.Path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	assignment := regexp.MustCompile(`(?m)^(export\s+)?` + regexp.QuoteMeta(variable) + `=.*$`)
+	line := variable + "=" + token
+	switch {
+	case assignment.Match(content):
+		content = assignment.ReplaceAllFunc(content, func(m []byte) []byte {
+			if bytes.HasPrefix(m, []byte("export")) {
+				return []byte("export " + line)
+			}
+			return []byte(line)
+		})
+	case len(content) > 0 && !bytes.HasSuffix(content, []byte("\n")):
+		content = append(content, []byte("\n"+line+"\n")...)
+	default:
+		content = append(content, []byte(line+"\n")...)
+	}
+	return ioutil.WriteFile(f.Path, content, 0600)
+}
+
+// TokenConfigFile replaces the access_token of the phrase section of a
+// .phrase.yml config file. Profiles and other content of the file are left
+// as is, see TokenConfigProfile to replace the token of a profile.
+type TokenConfigFile string
+
+func (path TokenConfigFile) WriteToken(token string) error {
+	return TokenConfigProfile{Path: string(path)}.WriteToken(token)
+}
+
+// TokenConfigProfile replaces the access_token of a profile of a .phrase.yml
+// config file, or the one of the phrase section if Profile is empty. Other
+// content of the file is left as is.
+type TokenConfigProfile struct {
+	Path    string
+	Profile string
+}
+
+func (p TokenConfigProfile) WriteToken(token string) error {
+	content, err := ioutil.ReadFile(p.Path)
+	if err != nil {
+		return err
+	}
+
+	doc, section, err := parseConfigDocument(content, false)
+	if err != nil {
+		return fmt.Errorf("%s: %s", p.Path, err)
+	}
+	if p.Profile != "" {
+		profiles := yamlMappingValue(section, "profiles")
+		if profiles != nil {
+			section = yamlMappingValue(profiles, p.Profile)
+		}
+		if profiles == nil || section == nil || section.Kind != yamlv3.MappingNode {
+			return fmt.Errorf("%s: profile %q is missing in config", p.Path, p.Profile)
+		}
+	}
+
+	if node := yamlMappingValue(section, "access_token"); node != nil {
+		err = updateYAMLNode(node, token, nil)
+	} else {
+		err = appendYAMLKey(section, "access_token", token)
+	}
+	if err != nil {
+		return err
+	}
+
+	content, err = encodeConfigDocument(doc)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(p.Path, content, 0600)
+}
+
+// DefaultTokenLifetime is the lifetime of rotated tokens whose old token
+// never expired, unless RotateOptions.ExpiresIn is set.
+const DefaultTokenLifetime = 90 * 24 * time.Hour
+
+// RotateOptions control how an access token is rotated.
+type RotateOptions struct {
+	// ExpiresIn is the lifetime of the new token, starting now. If zero, the
+	// lifetime of the old token is kept, or DefaultTokenLifetime is used if
+	// the old token never expired. Rotated tokens always expire.
+	ExpiresIn time.Duration
+
+	// Destination receives the new token before the old one is deleted.
+	Destination TokenDestination
+}
+
+// RotateAuthorization replaces the authorization with the given id by a new
+// one with the same scopes and note. The new token is verified with ShowUser
+// and written to the destination before the old authorization is deleted.
+// If verification or writing fails, the new authorization is deleted again
+// and the old one is kept, as is the destination.
+//
+// If the client itself authenticates with the rotated token, it is switched
+// to the new token.
+func RotateAuthorization(client *Client, id string, opts RotateOptions) (*AuthorizationWithToken, error) {
+	if opts.Destination == nil {
+		return nil, fmt.Errorf("no destination given for the rotated token")
+	}
+
+	old, err := client.AuthorizationShow(id)
+	if err != nil {
+		return nil, err
+	}
+
+	note := old.Note
+	params := &AuthorizationParams{Note: &note, Scopes: old.Scopes}
+	expiresAt := rotatedExpiry(old, opts.ExpiresIn)
+	params.ExpiresAt = &expiresAt
+
+	created, err := client.AuthorizationCreate(params)
+	if err != nil {
+		return nil, err
+	}
+
+	rollback := func(cause error) error {
+		if err := client.AuthorizationDelete(created.ID); err != nil {
+			return fmt.Errorf("%s (deleting new authorization %s failed: %s)", cause, created.ID, err)
+		}
+		return cause
+	}
+
+	verifier := *client
+	verifier.Credentials.Token = created.Token
+	verifier.Credentials.Username = ""
+	if _, err := verifier.ShowUser(); err != nil {
+		return nil, rollback(fmt.Errorf("verifying new token: %s", err))
+	}
+
+	if err := opts.Destination.WriteToken(created.Token); err != nil {
+		return nil, rollback(err)
+	}
+
+	usesOld := old.TokenLastEight != "" && strings.HasSuffix(client.Credentials.Token, old.TokenLastEight)
+	if usesOld {
+		client.Credentials.Token = created.Token
+	}
+
+	if err := client.AuthorizationDelete(old.ID); err != nil {
+		return created, fmt.Errorf("new token is in place but deleting old authorization %s failed: %s", old.ID, err)
+	}
+	return created, nil
+}
+
+func rotatedExpiry(old *Authorization, expiresIn time.Duration) *time.Time {
+	if expiresIn <= 0 {
+		expiresIn = DefaultTokenLifetime
+		if old.ExpiresAt != nil && old.CreatedAt != nil && old.ExpiresAt.After(*old.CreatedAt) {
+			expiresIn = old.ExpiresAt.Sub(*old.CreatedAt)
+		}
+	}
+	t := time.Now().Add(expiresIn).UTC().Truncate(time.Second)
+	return &t
+}
+
+// TokenAuditOptions define which authorizations AuditAuthorizations reports.
+type TokenAuditOptions struct {
+	// ExpiresWithin reports tokens expiring within the given duration.
+	ExpiresWithin time.Duration
+
+	// StaleAfter reports tokens not updated for longer than the given
+	// duration. The API does not expose a last used timestamp, so the
+	// authorization's updated_at is used instead: tokens in daily use that
+	// were never edited are reported as well, so check before deleting them.
+	StaleAfter time.Duration
+}
+
+// TokenAuditEntry is a single finding of AuditAuthorizations.
+type TokenAuditEntry struct {
+	Authorization *Authorization
+	Reason        string
+}
+
+// TokenAuditReport lists authorizations that need attention.
+type TokenAuditReport []*TokenAuditEntry
+
+func (report TokenAuditReport) String() string {
+	if len(report) == 0 {
+		return "No tokens need attention.\n"
+	}
+
+	buf := new(bytes.Buffer)
+	for _, e := range report {
+		a := e.Authorization
+		fmt.Fprintf(buf, "%s  ...%s  %-40q %s\n", a.ID, a.TokenLastEight, a.Note, e.Reason)
+	}
+	return buf.String()
+}
+
+// AuditAuthorizations lists the authorizations of the current user that are
+// expired, expire soon or were not updated for a while, see StaleAfter.
+func AuditAuthorizations(client *Client, opts TokenAuditOptions) (TokenAuditReport, error) {
+	now := time.Now()
+	report := TokenAuditReport{}
+	for page := 1; ; page++ {
+		list, err := client.AuthorizationsList(page, specPerPage)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range list {
+			switch {
+			case a.ExpiresAt != nil && a.ExpiresAt.Before(now):
+				report = append(report, &TokenAuditEntry{a, fmt.Sprintf("expired %s", a.ExpiresAt.Format(time.RFC3339))})
+			case a.ExpiresAt != nil && opts.ExpiresWithin > 0 && a.ExpiresAt.Before(now.Add(opts.ExpiresWithin)):
+				report = append(report, &TokenAuditEntry{a, fmt.Sprintf("expires %s", a.ExpiresAt.Format(time.RFC3339))})
+			case a.UpdatedAt != nil && opts.StaleAfter > 0 && a.UpdatedAt.Before(now.Add(-opts.StaleAfter)):
+				report = append(report, &TokenAuditEntry{a, fmt.Sprintf("not updated since %s", a.UpdatedAt.Format(time.RFC3339))})
+			}
+		}
+
+		if len(list) < specPerPage {
+			break
+		}
+	}
+
+	sort.SliceStable(report, func(i, j int) bool {
+		return report[i].Authorization.ID < report[j].Authorization.ID
+	})
+	return report, nil
+}
@@ -0,0 +1,167 @@
+package phraseapp
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRotateAuthorization(t *testing.T) {
+	var deleted []string
+	var created AuthorizationParams
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v2/authorizations/old":
+			io.WriteString(w, `{"id":"old","note":"ci","scopes":["read","write"],"token_last_eight":"12345678"}`)
+		case "POST /v2/authorizations":
+			json.NewDecoder(r.Body).Decode(&created)
+			w.WriteHeader(http.StatusCreated)
+			io.WriteString(w, `{"id":"new","token":"newtoken"}`)
+		case "GET /v2/user":
+			if r.Header.Get("Authorization") != "token newtoken" {
+				t.Errorf("expected verification with new token, got %q", r.Header.Get("Authorization"))
+			}
+			io.WriteString(w, `{"id":"u1"}`)
+		case "DELETE /v2/authorizations/old":
+			deleted = append(deleted, "old")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	dir, _ := ioutil.TempDir("", "")
+	envFile := filepath.Join(dir, ".env")
+	ioutil.WriteFile(envFile, []byte("FOO=bar\nexport PHRASEAPP_ACCESS_TOKEN=abc12345678\n"), 0600)
+
+	client, _ := NewClient(Credentials{Host: server.URL, Token: "abc12345678"}, false)
+	auth, err := RotateAuthorization(client, "old", RotateOptions{Destination: TokenEnvFile{Path: envFile}})
+	if err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+
+	if auth.Token != "newtoken" {
+		t.Errorf("expected token %q, got %q", "newtoken", auth.Token)
+	}
+	if created.Note == nil || *created.Note != "ci" || len(created.Scopes) != 2 {
+		t.Errorf("expected note and scopes to be copied, got %+v", created)
+	}
+	if len(deleted) != 1 {
+		t.Errorf("expected old authorization to be deleted")
+	}
+	if client.Credentials.Token != "newtoken" {
+		t.Errorf("expected client to use the new token, got %q", client.Credentials.Token)
+	}
+
+	content, _ := ioutil.ReadFile(envFile)
+	expContent := "FOO=bar\nexport PHRASEAPP_ACCESS_TOKEN=newtoken\n"
+	if string(content) != expContent {
+		t.Errorf("expected env file %q, got %q", expContent, content)
+	}
+}
+
+func TestRotatedExpiry(t *testing.T) {
+	created := time.Now().Add(-24 * time.Hour)
+	expires := created.Add(30 * 24 * time.Hour)
+	tests := []struct {
+		old       *Authorization
+		expiresIn time.Duration
+		exp       time.Duration
+	}{
+		{&Authorization{CreatedAt: &created, ExpiresAt: &expires}, 0, 30 * 24 * time.Hour},
+		{&Authorization{CreatedAt: &created, ExpiresAt: &expires}, time.Hour, time.Hour},
+		{&Authorization{CreatedAt: &created}, 0, DefaultTokenLifetime},
+		{&Authorization{CreatedAt: &created}, 7 * 24 * time.Hour, 7 * 24 * time.Hour},
+		{&Authorization{ExpiresAt: &expires}, 0, DefaultTokenLifetime},
+	}
+	for i, tt := range tests {
+		got := rotatedExpiry(tt.old, tt.expiresIn)
+		if got == nil {
+			t.Errorf("%d: expected the new token to expire", i)
+		} else if lifetime := time.Until(*got); lifetime > tt.exp || lifetime < tt.exp-time.Minute {
+			t.Errorf("%d: expected a lifetime of %s, got %s", i, tt.exp, lifetime)
+		}
+	}
+}
+
+func TestRotateAuthorizationVerificationFails(t *testing.T) {
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /v2/authorizations/old":
+			io.WriteString(w, `{"id":"old","note":"ci","scopes":["read"],"token_last_eight":"12345678"}`)
+		case "POST /v2/authorizations":
+			w.WriteHeader(http.StatusCreated)
+			io.WriteString(w, `{"id":"new","token":"newtoken"}`)
+		case "GET /v2/user":
+			w.WriteHeader(http.StatusUnauthorized)
+			io.WriteString(w, `{"message":"Unauthorized"}`)
+		case "DELETE /v2/authorizations/new":
+			deleted = append(deleted, "new")
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	dir, _ := ioutil.TempDir("", "")
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, ".phrase.yml")
+	config := "phrase:\n  access_token: abc12345678 # ci token\n"
+	ioutil.WriteFile(path, []byte(config), 0600)
+
+	client, _ := NewClient(Credentials{Host: server.URL, Token: "abc12345678"}, false)
+	_, err := RotateAuthorization(client, "old", RotateOptions{Destination: TokenConfigFile(path)})
+	if err == nil || !strings.HasPrefix(err.Error(), "verifying new token") {
+		t.Errorf("expected a verification error, got %v", err)
+	}
+	if len(deleted) != 1 {
+		t.Errorf("expected new authorization to be deleted")
+	}
+	if content, _ := ioutil.ReadFile(path); string(content) != config {
+		t.Errorf("expected config to be kept, got %q", content)
+	}
+	if client.Credentials.Token != "abc12345678" {
+		t.Errorf("expected client to keep the old token, got %q", client.Credentials.Token)
+	}
+}
+
+func TestTokenConfigFile(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "")
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, ".phrase.yml")
+	ioutil.WriteFile(path, []byte("phrase:\n  project_id: p1\n  profiles:\n    us:\n      access_token: us1 # us token\n"), 0600)
+
+	if err := TokenConfigFile(path).WriteToken("t1"); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if err := TokenConfigFile(path).WriteToken("t2"); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	if err := (TokenConfigProfile{Path: path, Profile: "us"}).WriteToken("us2"); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+
+	content, _ := ioutil.ReadFile(path)
+	expContent := "phrase:\n  project_id: p1\n  profiles:\n    us:\n      access_token: us2 # us token\n  access_token: t2\n"
+	if string(content) != expContent {
+		t.Errorf("expected config %q, got %q", expContent, content)
+	}
+
+	if err := (TokenConfigProfile{Path: path, Profile: "eu"}).WriteToken("eu1"); err == nil || !strings.HasSuffix(err.Error(), `profile "eu" is missing in config`) {
+		t.Errorf("expected a missing profile error, got %v", err)
+	}
+	ioutil.WriteFile(path, []byte("other: true\n"), 0600)
+	if err := TokenConfigFile(path).WriteToken("t3"); err == nil || !strings.HasSuffix(err.Error(), "'phrase' key is missing in config") {
+		t.Errorf("expected a missing section error, got %v", err)
+	}
+}
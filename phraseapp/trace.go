@@ -0,0 +1,150 @@
+package phraseapp
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"sync"
+	"time"
+)
+
+// Tracer starts spans. The client starts a span per API call, named after
+// the client method (e.g. "LocaleDownload") and carrying the path arguments
+// (project_id, id, ...) as attributes, with child spans "multipart", "http",
+// "cache" and "decode". The interface mirrors OpenTelemetry's tracer so a
+// bridge is a thin wrapper.
+type Tracer interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is a single traced operation.
+type Span interface {
+	SetAttribute(key string, value interface{})
+	RecordError(err error)
+	End()
+}
+
+type tracerKey struct{}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(string, interface{}) {}
+func (noopSpan) RecordError(error)                {}
+func (noopSpan) End()                             {}
+
+// startSpan starts the span of an API call. The returned context carries
+// the endpoint name and the tracer for child spans.
+func (client *Client) startSpan(endpoint string, attrs ...string) (context.Context, Span) {
+	ctx := context.WithValue(context.Background(), endpointKey{}, endpoint)
+	if client.Tracer == nil {
+		return ctx, noopSpan{}
+	}
+
+	ctx = context.WithValue(ctx, tracerKey{}, client.Tracer)
+	ctx, span := client.Tracer.Start(ctx, endpoint)
+	for i := 0; i+1 < len(attrs); i += 2 {
+		span.SetAttribute(attrs[i], attrs[i+1])
+	}
+	return ctx, span
+}
+
+// startChildSpan starts a span below the API call span in ctx.
+func startChildSpan(ctx context.Context, name string) (context.Context, Span) {
+	tracer, ok := ctx.Value(tracerKey{}).(Tracer)
+	if !ok {
+		return ctx, noopSpan{}
+	}
+	return tracer.Start(ctx, name)
+}
+
+func endSpan(span Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.End()
+}
+
+func decodeJSON(ctx context.Context, r io.Reader, v interface{}) error {
+	_, span := startChildSpan(ctx, "decode")
+	err := json.NewDecoder(r).Decode(v)
+	endSpan(span, err)
+	return err
+}
+
+func readBody(ctx context.Context, r io.Reader) ([]byte, error) {
+	_, span := startChildSpan(ctx, "decode")
+	b, err := ioutil.ReadAll(r)
+	span.SetAttribute("bytes", len(b))
+	endSpan(span, err)
+	return b, err
+}
+
+// RecordingTracer keeps all spans in memory. It is meant for tests.
+type RecordingTracer struct {
+	mu    sync.Mutex
+	spans []*RecordedSpan
+}
+
+// RecordedSpan is a span recorded by RecordingTracer.
+type RecordedSpan struct {
+	Name       string
+	Parent     *RecordedSpan
+	Attributes map[string]interface{}
+	Errors     []error
+	StartTime  time.Time
+	EndTime    time.Time
+
+	tracer *RecordingTracer
+}
+
+type recordedSpanKey struct{}
+
+func (t *RecordingTracer) Start(ctx context.Context, name string) (context.Context, Span) {
+	parent, _ := ctx.Value(recordedSpanKey{}).(*RecordedSpan)
+	span := &RecordedSpan{
+		Name:       name,
+		Parent:     parent,
+		Attributes: map[string]interface{}{},
+		StartTime:  time.Now(),
+		tracer:     t,
+	}
+
+	t.mu.Lock()
+	t.spans = append(t.spans, span)
+	t.mu.Unlock()
+
+	return context.WithValue(ctx, recordedSpanKey{}, span), span
+}
+
+// Spans returns the spans started so far, in start order.
+func (t *RecordingTracer) Spans() []*RecordedSpan {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]*RecordedSpan{}, t.spans...)
+}
+
+// Reset forgets all recorded spans.
+func (t *RecordingTracer) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.spans = nil
+}
+
+func (s *RecordedSpan) SetAttribute(key string, value interface{}) {
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+	s.Attributes[key] = value
+}
+
+func (s *RecordedSpan) RecordError(err error) {
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+	s.Errors = append(s.Errors, err)
+}
+
+func (s *RecordedSpan) End() {
+	s.tracer.mu.Lock()
+	defer s.tracer.mu.Unlock()
+	s.EndTime = time.Now()
+}
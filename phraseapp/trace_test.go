@@ -0,0 +1,95 @@
+package phraseapp
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestTracing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v2/projects/p1/locales/de/download":
+			io.WriteString(w, "hello: Hallo\n")
+		case "/v2/projects/p1/uploads":
+			w.WriteHeader(http.StatusCreated)
+			io.WriteString(w, `{"id":"u1"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	tracer := &RecordingTracer{}
+	client, _ := NewClient(Credentials{Host: server.URL, Token: "secret"}, false)
+	client.Tracer = tracer
+
+	if _, err := client.LocaleDownload("p1", "de", &LocaleDownloadParams{}); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+
+	spans := tracer.Spans()
+	if len(spans) != 3 {
+		t.Fatalf("expected 3 spans, got %d", len(spans))
+	}
+	root, httpSpan, decode := spans[0], spans[1], spans[2]
+	if root.Name != "LocaleDownload" || root.Parent != nil {
+		t.Errorf("expected root span LocaleDownload, got %q", root.Name)
+	}
+	if root.Attributes["project_id"] != "p1" || root.Attributes["id"] != "de" {
+		t.Errorf("expected path arguments as attributes, got %v", root.Attributes)
+	}
+	if httpSpan.Name != "http" || httpSpan.Parent != root || httpSpan.Attributes["status"] != 200 {
+		t.Errorf("unexpected http span %+v", httpSpan)
+	}
+	if decode.Name != "decode" || decode.Parent != root {
+		t.Errorf("unexpected decode span %+v", decode)
+	}
+	for _, s := range spans {
+		if s.EndTime.IsZero() {
+			t.Errorf("expected span %q to be ended", s.Name)
+		}
+	}
+
+	tracer.Reset()
+	if _, err := client.ProjectShow("missing"); err == nil {
+		t.Fatalf("expected an error")
+	}
+	spans = tracer.Spans()
+	if len(spans) != 2 || len(spans[0].Errors) != 1 {
+		t.Errorf("expected the error to be recorded on the root span, got %+v", spans)
+	}
+
+	dir, err := ioutil.TempDir("", "trace")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "de.yml")
+	if err := ioutil.WriteFile(file, []byte("hello: Hallo\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tracer.Reset()
+	if _, err := client.UploadCreate("p1", &UploadParams{File: &file, FileFormat: fileFormat("yml")}); err != nil {
+		t.Fatalf("didn't expect an error, got %q", err)
+	}
+	spans = tracer.Spans()
+	if len(spans) < 2 || spans[1].Name != "multipart" || spans[1].Parent != spans[0] {
+		t.Errorf("expected a multipart span below UploadCreate, got %+v", spans)
+	}
+
+	tracer.Reset()
+	missing := filepath.Join(dir, "missing.yml")
+	if _, err := client.UploadCreate("p1", &UploadParams{File: &missing, FileFormat: fileFormat("yml")}); err == nil {
+		t.Fatalf("expected an error for a missing file")
+	}
+	spans = tracer.Spans()
+	if len(spans) != 2 || spans[1].Name != "multipart" || spans[1].EndTime.IsZero() || len(spans[1].Errors) != 1 {
+		t.Errorf("expected the multipart span to be ended with the error, got %+v", spans)
+	}
+}